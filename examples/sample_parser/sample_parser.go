@@ -5,10 +5,8 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
-	"path/filepath"
 	"runtime"
 	"strings"
 
@@ -18,8 +16,8 @@ import (
 // dummy function to satisfy the function signature of getExternalDocForId
 func getExternalDocForId(id string) (map[string]interface{}, error) {
 	// fmt.Println("getExternalDocForId called with id:", id)
-	// Put your own code here in this method but always return this exact error if the document is not found
-	return nil, fmt.Errorf("%s: %s", parser.DOC_NOT_FOUND, id)
+	// Put your own code here in this method but always return an error wrapping parser.ErrDocNotFound if the document is not found
+	return nil, fmt.Errorf("%w: %s", parser.ErrDocNotFound, id)
 }
 
 func ReadJsonFromGzipFile(filename string) (map[string]interface{}, error) {
@@ -48,10 +46,10 @@ func ReadJsonFromGzipFile(filename string) (map[string]interface{}, error) {
 }
 
 func ParseRegressionSuite() error {
-	var doc map[string]interface{}
-	var err error
 	var testdata_directory string
 	var dataSetName string
+	var deadLetterPath string
+	var workers int
 	output_directory := "/tmp"
 	Usage := func() {
 		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
@@ -60,6 +58,8 @@ func ParseRegressionSuite() error {
 	flag.StringVar(&testdata_directory, "path", "", "Required - Path to the regression test data")
 	flag.StringVar(&dataSetName, "dataset", "", "Required - Name of the dataset - must be 10 characters or less")
 	flag.StringVar(&output_directory, "outdir", "", "Optional - Path to the output directory - defaults to /tmp")
+	flag.IntVar(&workers, "workers", runtime.NumCPU(), "Optional - Number of files to parse in parallel - defaults to the number of CPUs")
+	flag.StringVar(&deadLetterPath, "deadletter", "", "Optional - Path to a file where rejected lines are written as JSON lines")
 	flag.Parse()
 	if testdata_directory == "" {
 		Usage()
@@ -78,23 +78,39 @@ func ParseRegressionSuite() error {
 	if !strings.HasSuffix(output_directory, "/") {
 		output_directory += "/"
 	}
-	// walk through the directory to read files using filepath.Walk
-	err = filepath.Walk(testdata_directory, func(path string, info os.FileInfo, err error) error {
+	var readOpts []parser.ReadOption
+	if deadLetterPath != "" {
+		deadLetters, err := os.Create(deadLetterPath)
 		if err != nil {
+			log.Printf("%v", err)
 			return err
 		}
-		if info.IsDir() { // skip directories - we only want the files
-			return nil
-		}
-		doc = parseFile(dataSetName, path, info, doc)
-		return nil
-	})
+		defer deadLetters.Close()
+		readOpts = append(readOpts, parser.WithDeadLetterWriter(deadLetters))
+	}
+	// parse the files in parallel - the documents are merged in path order so the output is the same for any number of workers
+	readOpts = append(readOpts, parser.WithWorkers(workers))
+	// the documents are kept in memory, getExternalDocForId is used to look up documents that are not in memory
+	store := parser.NewCallbackStore(parser.NewMemoryStore(nil), getExternalDocForId)
+	summary, err := parser.ParseDirectoryToStore(testdata_directory, dataSetName, store, readOpts...)
 	if err != nil {
 		log.Printf("%v", err)
 		return err
 	}
+	for _, fileErr := range summary.FileErrors {
+		log.Printf("%v - skipping rest of file\n", fileErr)
+	}
+	for _, fileSummary := range summary.Files {
+		for _, lineErr := range fileSummary.Errors {
+			log.Printf("Error parsing line: %s\n", lineErr)
+		}
+	}
+	log.Printf("parsed %d files, %d data lines, %d errors, %d documents\n", len(summary.Files), summary.DataLines, summary.ErrorLines, summary.Documents)
+	for fileLineType, collisions := range summary.Collisions {
+		log.Printf("%s: %d data key collisions, %d of them duplicates\n", fileLineType, collisions, summary.Duplicates[fileLineType])
+	}
 	// write output to json	gzipped file
-	err = parser.WriteJsonToCompressedFile(doc, output_directory+dataSetName+".json.gz")
+	err = parser.WriteStoreJsonToCompressedFile(store, output_directory+dataSetName+".json.gz")
 	if err != nil {
 		log.Printf("%v", err)
 		return err
@@ -102,45 +118,6 @@ func ParseRegressionSuite() error {
 	return nil
 }
 
-func parseFile(dataSetName string, fPath string, fileInfos os.FileInfo, doc map[string]interface{}) map[string]interface{} {
-	file, err := os.Open(fPath) // open the file
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer file.Close()
-	fName := fileInfos.Name()
-	if strings.HasSuffix(fName, ".swp") || strings.HasSuffix(fName, ".DS_Store") {
-		// skip the swp files - might be editing a file and don't want to parse the .swp file
-		// and skip any .DS_Store files
-		return doc
-	}
-	rawData, err := io.ReadAll(file)
-	if err != nil {
-		log.Fatal(err)
-	}
-	lines := strings.Split(string(rawData), "\n")
-	if len(lines) == 0 || lines[0] == "" {
-		log.Printf("empty file %s - skipping\n", fPath)
-		return doc
-	}
-	headerLine := lines[0]
-	if !strings.HasPrefix(headerLine, "VERSION") {
-		log.Printf("missing VERSION at start of header line - bad header line? for file %s - skipping rest of file\n", fPath)
-		return doc
-	}
-	for line := range lines {
-		if line == 0 || lines[line] == "" {
-			continue
-		}
-		dataLine := lines[line]
-		doc, err = parser.ParseLine(dataSetName, headerLine, dataLine, &doc, fName, getExternalDocForId)
-		if err != nil {
-			log.Printf("Error parsing line: %s for file %s\n", err, fName)
-		}
-	}
-	return doc
-}
-
 func main() {
 	fmt.Println("environment:" + runtime.GOOS + "_" + runtime.GOARCH)
 
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The -check mode regenerates the linetypes packages in memory and compares them with the committed
+pkg/linetypes/<version>/linetypes.go and pkg/linetypes/base/linetypes.go files, i.e.
+
+	go run ./generator -check -source-dir=/tmp/met_v12.0
+	go run ./generator -check -version=v12.0
+
+It prints the line types that would be added or removed and the header and data columns that would be added,
+removed or retyped, and it exits with a non-zero status if any version differs. The line types of a version
+include the ones that it aliases from the base package or from the package of an earlier version. A version whose
+generated code differs in any way (i.e. a changed fill function or formatting) is reported with "the generated code
+differs", with its column differences or on its own, and so is a base package that differs, and so is a version
+whose column types table in pkg/util/column_defs (see tables.go) is not the generated one. The lists of input
+hashes in the header comments are compared too, so a package that was generated from other inputs than the ones
+that -check reads is reported as differing. A tree that was generated from the inputs that -check reads is up to date.
+*/
+
+// lineTypeColumns are the header and data columns of a generated line type and their Go types.
+type lineTypeColumns struct {
+	header map[string]string
+	data   map[string]string
+}
+
+/*
+readLineTypeColumns returns the columns of the line types of a generated linetypes package, by fileLineType.
+The line types are the data structs that have a header struct, i.e. STAT_CNT and STAT_CNT_header, that are
+declared in the package src, as structs or as aliases of the structs of another package, i.e. base.STAT_CNT.
+The aliases are resolved in the imported packages src, by package name.
+*/
+func readLineTypeColumns(src []byte, imported map[string][]byte) (map[string]lineTypeColumns, error) {
+	structs, aliases, err := readStructs(src)
+	if err != nil {
+		return nil, err
+	}
+	importedStructs := make(map[string]map[string]map[string]string)
+	for _, alias := range aliases {
+		if _, ok := importedStructs[alias.pkg]; ok || imported[alias.pkg] == nil {
+			continue
+		}
+		pkgStructs, _, err := readStructs(imported[alias.pkg])
+		if err != nil {
+			return nil, err
+		}
+		importedStructs[alias.pkg] = pkgStructs
+	}
+	lookup := func(name string) (map[string]string, bool) {
+		if fields, ok := structs[name]; ok {
+			return fields, true
+		}
+		alias, ok := aliases[name]
+		if !ok {
+			return nil, false
+		}
+		fields, ok := importedStructs[alias.pkg][alias.name]
+		return fields, ok
+	}
+	lineTypes := make(map[string]lineTypeColumns)
+	for _, name := range slices.Concat(getSortedKeys(structs), getSortedKeys(aliases)) {
+		data, isStruct := lookup(name)
+		header, hasHeader := lookup(name + "_header")
+		if isStruct && hasHeader {
+			lineTypes[name] = lineTypeColumns{header: header, data: data}
+		}
+	}
+	return lineTypes, nil
+}
+
+// typeAlias is the type of another package that a type alias of a package stands for, i.e. base.STAT_FHO.
+type typeAlias struct {
+	pkg  string
+	name string
+}
+
+// readStructs returns the fields of the structs of a package src and their Go types, and its type aliases of other packages, by name.
+func readStructs(src []byte) (map[string]map[string]string, map[string]typeAlias, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "linetypes.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, nil, err
+	}
+	structs := make(map[string]map[string]string)
+	aliases := make(map[string]typeAlias)
+	ast.Inspect(file, func(node ast.Node) bool {
+		typeSpec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		switch typ := typeSpec.Type.(type) {
+		case *ast.SelectorExpr:
+			if pkg, ok := typ.X.(*ast.Ident); ok {
+				aliases[typeSpec.Name.Name] = typeAlias{pkg: pkg.Name, name: typ.Sel.Name}
+			}
+		case *ast.StructType:
+			fields := make(map[string]string)
+			for _, field := range typ.Fields.List {
+				for _, name := range field.Names {
+					if name.Name != "MISSING" {
+						fields[name.Name] = types.ExprString(field.Type)
+					}
+				}
+			}
+			structs[typeSpec.Name.Name] = fields
+		}
+		return false
+	})
+	return structs, aliases, nil
+}
+
+// diffLineTypeColumns returns the differences between the committed and the generated line types, sorted by line type.
+func diffLineTypeColumns(committed map[string]lineTypeColumns, generated map[string]lineTypeColumns) []string {
+	var diffs []string
+	for _, fileLineType := range getSortedKeys(mergeKeys(committed, generated)) {
+		committedColumns, inCommitted := committed[fileLineType]
+		generatedColumns, inGenerated := generated[fileLineType]
+		switch {
+		case !inCommitted:
+			diffs = append(diffs, "added line type "+fileLineType)
+		case !inGenerated:
+			diffs = append(diffs, "removed line type "+fileLineType)
+		default:
+			diffs = append(diffs, diffColumns(fileLineType, "header", committedColumns.header, generatedColumns.header)...)
+			diffs = append(diffs, diffColumns(fileLineType, "data", committedColumns.data, generatedColumns.data)...)
+		}
+	}
+	return diffs
+}
+
+// diffColumns returns the added, removed and retyped columns of a line type, sorted by column.
+func diffColumns(fileLineType string, section string, committed map[string]string, generated map[string]string) []string {
+	var diffs []string
+	for _, column := range getSortedKeys(mergeKeys(committed, generated)) {
+		committedType, inCommitted := committed[column]
+		generatedType, inGenerated := generated[column]
+		switch {
+		case !inCommitted:
+			diffs = append(diffs, fmt.Sprintf("%s: added %s column %s %s", fileLineType, section, column, generatedType))
+		case !inGenerated:
+			diffs = append(diffs, fmt.Sprintf("%s: removed %s column %s %s", fileLineType, section, column, committedType))
+		case committedType != generatedType:
+			diffs = append(diffs, fmt.Sprintf("%s: retyped %s column %s %s -> %s", fileLineType, section, column, committedType, generatedType))
+		}
+	}
+	return diffs
+}
+
+// mergeKeys returns a map with the keys of both maps.
+func mergeKeys[V any](a map[string]V, b map[string]V) map[string]bool {
+	keys := make(map[string]bool, len(a)+len(b))
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	return keys
+}
+
+/*
+checkVersion returns the differences between the generated packages and the committed package of a parser version.
+The committed packages are in the linetypesDir, i.e. pkg/linetypes/v12_0/linetypes.go.
+*/
+func checkVersion(packages map[string][]byte, parserVersion string, linetypesDir string) ([]string, error) {
+	committed, err := os.ReadFile(filepath.Join(linetypesDir, parserVersion, "linetypes.go"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading the committed linetypes: %w", err)
+	}
+	generated := packages[parserVersion]
+	// the aliases of the committed package are resolved in the committed packages that it can import
+	committedPackages := make(map[string][]byte, len(packages))
+	for name := range packages {
+		if src, err := os.ReadFile(filepath.Join(linetypesDir, name, "linetypes.go")); err == nil {
+			committedPackages[name] = src
+		}
+	}
+	committedLineTypes, err := readLineTypeColumns(committed, committedPackages)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the committed linetypes of %s: %w", parserVersion, err)
+	}
+	generatedLineTypes, err := readLineTypeColumns(generated, packages)
+	if err != nil {
+		return nil, err
+	}
+	diffs := diffLineTypeColumns(committedLineTypes, generatedLineTypes)
+	// a change of the code or of the formatting is reported with the column differences or on its own
+	if !bytes.Equal(committed, generated) {
+		diffs = append(diffs, "the generated code differs")
+	}
+	return diffs, nil
+}
+
+/*
+checkLineTypes regenerates the packages of allVersions and checks the committed base package and the committed
+package of every parser version in versions, which are in the linetypesDir i.e. pkg/linetypes/v12_0/linetypes.go,
+and its column types table in the columnDefsDir. It writes the differences to w and returns false if any package differs.
+*/
+func checkLineTypes(w io.Writer, allVersions []string, versions []string, linetypesDir string, columnDefsDir string) bool {
+	packages, err := generateLineTypes(allVersions)
+	if err != nil {
+		fmt.Fprintln(w, err)
+		return false
+	}
+	tables, err := generateColumnTypeTables(packages, allVersions)
+	if err != nil {
+		fmt.Fprintln(w, err)
+		return false
+	}
+	committedBase, err := os.ReadFile(filepath.Join(linetypesDir, basePackage, "linetypes.go"))
+	if err != nil {
+		fmt.Fprintf(w, "%s: error reading the committed linetypes: %v\n", basePackage, err)
+		return false
+	}
+	ok := true
+	for _, parserVersion := range versions {
+		diffs, err := checkVersion(packages, parserVersion, linetypesDir)
+		if err == nil {
+			diffs = append(diffs, checkColumnTypeTable(tables[parserVersion], filepath.Join(columnDefsDir, util.ColumnTypesFileName(parserVersion)))...)
+		}
+		switch {
+		case err != nil:
+			fmt.Fprintf(w, "%s: %v\n", parserVersion, err)
+			ok = false
+		case len(diffs) == 0:
+			fmt.Fprintf(w, "%s: up to date\n", parserVersion)
+		default:
+			fmt.Fprintf(w, "%s: %d differences\n", parserVersion, len(diffs))
+			for _, diff := range diffs {
+				fmt.Fprintf(w, "    %s\n", diff)
+			}
+			ok = false
+		}
+	}
+	if bytes.Equal(committedBase, packages[basePackage]) {
+		fmt.Fprintf(w, "%s: up to date\n", basePackage)
+	} else {
+		fmt.Fprintf(w, "%s: the generated code differs\n", basePackage)
+		ok = false
+	}
+	return ok
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The generator config holds the knowledge about the MET columns that is not in the MET inputs, so that fixing
+the type of a field or adding a new N_* repeating sequence does not need a change to the generator code.
+The default config is config.json next to this file, it is embedded in the generator and -config loads another one.
+
+	fieldTypes         the types of fields that are wrong or missing in the MET source and user guide files,
+	                   i.e. "AMRD": "int". They override the types that were found in the MET inputs.
+	versionFieldTypes  field types for one parser version i.e. "v12_0", they override fieldTypes
+	repeatingGroups    the (N_*) columns of the met_header_columns files that start a repeated sequence of fields.
+	                   The fields of a sequence are named by its keyPrefixes and converted to its elementType.
+	                   The sequences of a group can depend on the fileLineType i.e. (N_THRESH) is THRESH_n OY_n ON_n
+	                   for STAT_PCT and THRESH_n PROB_n for TCST_PROBRIRW, a sequence without lineTypes is for any line type.
+	                   The "matrix" layout is the N_CAT x N_CAT contingency table of MCTC lines, keyed i.e. F1_O2.
+	patterns           regular expressions for the columns that are part of a repeated sequence i.e. THRESH_[0-9]*
+	                   and their types
+	columnSpellings    sequences of columns of the met_header_columns files that stand for one column of some line
+	                   types, i.e. "(N_RANK) RANK_[0-9]*" is the single RANK column of STAT_ORANK lines
+*/
+
+// configVersion is the version of the config format that this generator reads.
+const configVersion = 1
+
+//go:embed config.json
+var defaultConfigJson []byte
+
+type generatorConfig struct {
+	ConfigVersion     int                          `json:"configVersion"`
+	FieldTypes        map[string]string            `json:"fieldTypes"`
+	VersionFieldTypes map[string]map[string]string `json:"versionFieldTypes"`
+	RepeatingGroups   []repeatingGroup             `json:"repeatingGroups"`
+	Patterns          []patternConfig              `json:"patterns"`
+	ColumnSpellings   []util.ColumnSpelling        `json:"columnSpellings"`
+}
+
+// the repeating groups are written to the column types tables of the util package, which the engine package reads
+type (
+	repeatingGroup = util.RepeatingGroup
+	groupSequence  = util.GroupSequence
+)
+
+type patternConfig struct {
+	Match       string `json:"match"`
+	Type        string `json:"type"`
+	StructField string `json:"structField"`
+	StructType  string `json:"structType"`
+}
+
+// config is the generator config of this run, see getConfig.
+var config *generatorConfig
+
+// elementTypes are the types that a field can have in the generated code.
+var elementTypes = []string{"int", "float64", "string"}
+
+// getConfig returns the generator config, the default config unless main loaded another one.
+func getConfig() *generatorConfig {
+	if config == nil {
+		defaultConfig, err := parseConfig(defaultConfigJson)
+		if err != nil {
+			panic("invalid generator/config.json: " + err.Error())
+		}
+		config = defaultConfig
+	}
+	return config
+}
+
+// loadConfig reads a generator config file.
+func loadConfig(path string) (*generatorConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the generator config: %w", err)
+	}
+	cfg, err := parseConfig(content)
+	if err != nil {
+		return nil, fmt.Errorf("error in the generator config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// parseConfig parses and checks a generator config.
+func parseConfig(content []byte) (*generatorConfig, error) {
+	var cfg generatorConfig
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.ConfigVersion != configVersion {
+		return nil, fmt.Errorf("configVersion is %d, this generator reads version %d", cfg.ConfigVersion, configVersion)
+	}
+	for field, fieldType := range cfg.FieldTypes {
+		if !slices.Contains(elementTypes, fieldType) {
+			return nil, fmt.Errorf("fieldTypes: %s has an unknown type %q", field, fieldType)
+		}
+	}
+	for version, fieldTypes := range cfg.VersionFieldTypes {
+		for field, fieldType := range fieldTypes {
+			if !slices.Contains(elementTypes, fieldType) {
+				return nil, fmt.Errorf("versionFieldTypes: %s %s has an unknown type %q", version, field, fieldType)
+			}
+		}
+	}
+	for _, group := range cfg.RepeatingGroups {
+		if !strings.HasPrefix(group.Term, "(N_") || !strings.HasSuffix(group.Term, ")") {
+			return nil, fmt.Errorf("repeatingGroups: %q is not an (N_*) term", group.Term)
+		}
+		if _, err := regexp.Compile(group.Term); err != nil {
+			return nil, fmt.Errorf("repeatingGroups: %s: %w", group.Term, err)
+		}
+		if group.StructField == "" || len(group.Sequences) == 0 {
+			return nil, fmt.Errorf("repeatingGroups: %s needs a structField and sequences", group.Term)
+		}
+		for _, sequence := range group.Sequences {
+			if len(sequence.KeyPrefixes) == 0 || !slices.Contains(elementTypes, sequence.ElementType) {
+				return nil, fmt.Errorf("repeatingGroups: %s needs keyPrefixes and an elementType for every sequence", group.Term)
+			}
+			if group.Layout == "matrix" && (len(sequence.KeyPrefixes) != 2 || sequence.ElementType != "int") {
+				return nil, fmt.Errorf("repeatingGroups: the matrix %s needs two keyPrefixes and int elements", group.Term)
+			}
+		}
+		if group.Layout != "" && group.Layout != "matrix" {
+			return nil, fmt.Errorf("repeatingGroups: %s has an unknown layout %q", group.Term, group.Layout)
+		}
+	}
+	for _, pattern := range cfg.Patterns {
+		if _, err := regexp.Compile(pattern.Match); err != nil {
+			return nil, fmt.Errorf("patterns: %s: %w", pattern.Match, err)
+		}
+	}
+	for _, spelling := range cfg.ColumnSpellings {
+		if len(spelling.LineTypes) == 0 || len(spelling.Columns) == 0 || spelling.Column == "" {
+			return nil, fmt.Errorf("columnSpellings: %q needs lineTypes, columns and a column", strings.Join(spelling.Columns, " "))
+		}
+	}
+	return &cfg, nil
+}
+
+// fieldTypes returns the field types of the config for a parser version, the versionFieldTypes override the fieldTypes.
+func (c *generatorConfig) fieldTypes(parserVersion string) map[string]string {
+	fieldTypes := make(map[string]string, len(c.FieldTypes))
+	for field, fieldType := range c.FieldTypes {
+		fieldTypes[field] = fieldType
+	}
+	for field, fieldType := range c.VersionFieldTypes[parserVersion] {
+		fieldTypes[field] = fieldType
+	}
+	return fieldTypes
+}
+
+// repeatingGroup returns the repeating group of an (N_*) term and its sequence for a fileLineType.
+func (c *generatorConfig) repeatingGroup(term string, fileLineType string) (repeatingGroup, groupSequence, bool) {
+	return util.FindRepeatingGroup(c.RepeatingGroups, term, fileLineType)
+}
+
+// setConfig replaces the generator config, before any patterns are compiled.
+func setConfig(cfg *generatorConfig) {
+	config = cfg
+	patterns = nil
+}
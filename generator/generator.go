@@ -3,16 +3,12 @@ package main
 import (
 	"flag"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/NOAA-GSL/METstat2json/pkg/util"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 /*
@@ -48,29 +44,29 @@ type Pattern struct {
 
 var metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v12_0
 
+// metParserVersion is the parser version i.e. v12_0 that is generated, for the versionFieldTypes of the generator config
+var metParserVersion = "v12_0"
+
 var metSrcFiles = util.MetSrcFiles
 
 var metUserDocFiles = util.MetUserDocFiles
 
 func setMetVersion(parserVersion string) error {
-	switch parserVersion {
-	case "v12_0":
-		metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v12_0
-	case "v11_1":
-		metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v11_1
-	case "v11_0":
-		metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v11_0
-	case "v10_1":
-		metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v10_1
-	case "v10_0":
-		metHeaderColumnsFileUrl = util.MetHeaderColumnsFileUrl_v10_0
-	default:
-		return fmt.Errorf("unsupported MET parserVersion: %s - supported are v12_0, v11_1, v11_0, v10_1, v10_0", parserVersion)
+	url, ok := util.MetHeaderColumnsFileUrls[parserVersion]
+	if !ok {
+		versions := make([]string, 0, len(util.MetHeaderColumnsFileUrls))
+		for version := range util.MetHeaderColumnsFileUrls {
+			versions = append(versions, version)
+		}
+		util.SortVersions(versions)
+		return fmt.Errorf("unsupported MET parserVersion: %s - supported are %s", parserVersion, strings.Join(versions, ", "))
 	}
+	metHeaderColumnsFileUrl = url
+	metParserVersion = parserVersion
 	return nil
 }
 
-var patterns = make(map[string]Pattern)
+var patterns []Pattern
 
 /*
 The output of this program is a series of structs that can be used to define the header
@@ -81,40 +77,150 @@ function. See the notes in that function for more information.
 */
 
 func main() {
-	type HeaderStructs map[string]string
-	type DataStructs map[string]string
-
-	// Using the header definitions in the appropriate version of data/table_files/met_header_columns_X.X.txt
-	// currently 12.0 to get the required header column definitions and then using
-	// using the https://raw.githubusercontent.com/dtcenter/MET/refs/heads/main_v12.0/docs/Users_Guide
-	// to get the stat field types. We create a map of field names to field types. Then we have to re-iterate
-	// over the header definitions to create the structs and functions to fill the structs.
-	// read the header columns file
 	var version string
-	flag.StringVar(&version, "version", "", "Specify the parser version (e.g., -version=v12.0|v11.1|v11.0|v10.1|v10.0)")
+	flag.StringVar(&version, "version", "", "Specify the parser version for -check and -infer-dir (e.g., -version=v12.0|v11.1|v11.0|v10.1|v10.0)")
+	flag.StringVar(&sources.dir, "source-dir", "", "Read the MET files from a local MET checkout or snapshot directory instead of downloading them")
+	flag.StringVar(&sources.snapshotDir, "snapshot-dir", "", "Save the downloaded MET files to a snapshot directory for -source-dir")
+	var configPath string
+	var inferDir string
+	flag.StringVar(&inferDir, "infer-dir", "", "Infer the column types from the MET output files in a directory and report them instead of generating code")
+	flag.StringVar(&configPath, "config", "", "Read the field types and repeating sequences from a generator config file instead of generator/config.json")
+	var check bool
+	var linetypesDir string
+	flag.BoolVar(&check, "check", false, "Compare the committed linetypes packages with the generated code and report the differences instead of generating code")
+	flag.StringVar(&linetypesDir, "linetypes-dir", "pkg/linetypes", "The directory of the linetypes packages that are generated, or compared by -check")
+	var columnDefsDir string
+	flag.StringVar(&columnDefsDir, "column-defs-dir", "pkg/util/column_defs", "The directory of the column types tables that are generated, or compared by -check")
 	flag.Parse()
+	if configPath != "" {
+		cfg, err := loadConfig(configPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		setConfig(cfg)
+	}
 	parserVersion := strings.ReplaceAll(version, ".", "_")
-	err := setMetVersion(parserVersion)
+	// every version is generated together, because the base package depends on all of them
+	allVersions := getSortedKeys(util.MetHeaderColumnsFileUrls)
+	util.SortVersions(allVersions)
+	if check {
+		// the drift check compares -version, or every version, with the committed packages
+		versions := []string{parserVersion}
+		if version == "" {
+			versions = allVersions
+		}
+		if !checkLineTypes(os.Stdout, allVersions, versions, linetypesDir, columnDefsDir) {
+			os.Exit(1)
+		}
+		return
+	}
+	if inferDir != "" {
+		// the type inference pass reports the column types of a corpus instead of generating code
+		met_header_columns_lines, metDataTypesForLines, fieldNameMap, err := readMetInputs(parserVersion)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		corpus, err := inferCorpusTypes(inferDir, parserVersion, met_header_columns_lines)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		report, err := inferenceReport(inferDir, parserVersion, corpus, corpus.findTypes(metDataTypesForLines, fieldNameMap))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Print(report)
+		return
+	}
+	if version != "" {
+		fmt.Fprintln(os.Stderr, "-version is for -check and -infer-dir, the linetypes packages of every version are generated together")
+		os.Exit(1)
+	}
+	packages, err := generateLineTypes(allVersions)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeLineTypePackages(linetypesDir, packages); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	tables, err := generateColumnTypeTables(packages, allVersions)
 	if err != nil {
-		fmt.Println("error setting MET version: ", err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeColumnTypeTables(columnDefsDir, tables); err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
-	met_header_columns_lines, fieldNameMap := getColumnLinesAndMapForUrl(metHeaderColumnsFileUrl)
+}
+
+/*
+readMetInputs reads the MET inputs of a parser version, the lines of the met_header_columns file, the data types
+of the fields from the MET source and user guide files and the generator config, and the map of all field names.
+*/
+func readMetInputs(parserVersion string) ([]string, map[string]string, map[string]string, error) {
+	// Using the header definitions in the appropriate version of data/table_files/met_header_columns_X.X.txt
+	// currently 12.0 to get the required header column definitions and then using
+	// using the https://raw.githubusercontent.com/dtcenter/MET/refs/heads/main_v12.0/docs/Users_Guide
+	// to get the stat field types. We create a map of field names to field types.
+	if err := setMetVersion(parserVersion); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting MET version: %w", err)
+	}
+	met_header_columns_lines, fieldNameMap, err := getColumnLinesAndMapForUrl(metHeaderColumnsFileUrl)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading the met_header_columns file: %w", err)
+	}
 	metDataTypesForLines := make(map[string]string)
-	metDataTypesForLines = fillMetDataMapFromSrcFiles(metDataTypesForLines, fieldNameMap)
-	metDataTypesForLines = fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap)
-	// Use a map to keep track of unique headerStructs and dataStructs.
-	dataStructs := make(DataStructs)
-	headerStructs := make(HeaderStructs)
-	// fill...Funcs are the same as header and data Structs but for the fill functions
-	fillHeaderFuncs := make(HeaderStructs)
-	fillDataFuncs := make(DataStructs)
-	// create the getDocFoID function
-	docIDString := "func GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {\n\tdoc := make(map[string] interface{})\n\t" +
-		"// add the metadata to the doc\n\tfor key, value := range metaDataMap {\n\t\tdoc[key] = value\n\t}\n\tswitch fileLineType {\n"
-	addDataElementString := "func AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {\n\tswitch fileLineType {\n"
+	metDataTypesForLines, err = fillMetDataMapFromSrcFiles(metDataTypesForLines, fieldNameMap)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading the MET source files: %w", err)
+	}
+	metDataTypesForLines, err = fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading the MET user guide files: %w", err)
+	}
+	return met_header_columns_lines, metDataTypesForLines, fieldNameMap, nil
+}
+
+// lineTypeCode is the generated code of a fileLineType
+type lineTypeCode struct {
+	headerStruct       string
+	fillHeader         string
+	dataStruct         string
+	fillData           string
+	getDocIDCase       string
+	addDataElementCase string
+	headerColumns      []string
+}
+
+// versionCode is the generated code of the line types of a parser version, see generateLineTypes
+type versionCode struct {
+	parserVersion    string
+	undefinedComment string
+	hashComment      string
+	columnDefsUrl    string
+	fileLineTypes    []string // in the order of the met_header_columns file
+	lineTypes        map[string]lineTypeCode
+}
+
+// buildVersionCode reads the MET inputs of a parser version and generates the code of its line types.
+func buildVersionCode(parserVersion string) (*versionCode, error) {
+	met_header_columns_lines, metDataTypesForLines, fieldNameMap, err := readMetInputs(parserVersion)
+	if err != nil {
+		return nil, err
+	}
+	code := &versionCode{
+		parserVersion:    parserVersion,
+		undefinedComment: getUndefinedDataTypesComment(fieldNameMap),
+		columnDefsUrl:    metHeaderColumnsFileUrl,
+		lineTypes:        make(map[string]lineTypeCode),
+	}
 	// iterate through every line in the met_header_columns file to create the getDocId case and the structs and functions for each met header column line
-	var docStructName, headerStructName, headerStructString, fillHeaderString string
 	for _, line := range met_header_columns_lines {
 		// get the prefix from the line
 		fieldStr, fileType, lineType, err := getFileLineType(line)
@@ -124,126 +230,62 @@ func main() {
 		fileLineType := fileType + "_" + lineType
 		// split the line into header and data fields
 		headerFields, dataFields := util.SplitColumnDefLine(fileLineType, fieldStr)
-		// create the header struct string and the fillHeader function string
-		docStructName, headerStructName, headerStructString, fillHeaderString, docIDString, addDataElementString = getHeaderStructureString(fileType, lineType, docIDString, addDataElementString, headerFields, metDataTypesForLines)
-		// add the header struct string to the map for printing later
-		headerStructs[headerStructName] = headerStructString
-		// add the fillHeader function string to the map for printing later
-		fillHeaderFuncs[headerStructName] = fillHeaderString
+		dataFields = util.RespellColumns(getConfig().ColumnSpellings, fileLineType, dataFields)
+		// the header columns of every fileLineType, for the version compatibility check of the parser
+		headerColumns := slices.Clone(headerFields)
+		// create the header struct string, the fillHeader function string and the getDocForId and addDataElement cases
+		docStructName, _, headerStructString, fillHeaderString, docIDCase, addDataElementCase := getHeaderStructureString(fileType, lineType, "", "", headerFields, metDataTypesForLines)
 		// create dataStructure and fillStructure function strings for the data struct
 		fillStructureString, dataStruct := getFillStructureString(docStructName, dataFields, metDataTypesForLines, fileType, lineType)
-		dataStructs[docStructName] = dataStruct
-		fillDataFuncs[docStructName] = fillStructureString
-	}
-	// end the switch statements in the getDocForId and addDataElementStrings now that the line loop is over
-	docIDString += "\tdefault:\n\t\treturn nil, errors.New(\"GetDocForId: Unknown file_line type:\" + fileLineType)\n\t}\n\treturn doc, nil\n}\n"
-	addDataElementString += "\tdefault:\n\t\treturn nil, errors.New(\"AddDataElement: Unknown file_line type:\" + fileLineType)\n\t}\n\treturn *doc, nil\n}\n"
-
-	// print the package - header structs, fillHeader functions, data structs, fillStructure functions, getDocForId functions, addDataElement functions
-	fmt.Println("package " + parserVersion)
-	fmt.Println("")
-	fmt.Println("import (\n\t\"strconv\"\n\t\"errors\"\n\t\"fmt\"\n\t\"time\"\n)")
-	fmt.Println("\n/*\nTHIS CODE IS AUTOMATICALLY GENERATED - DO NOT EDIT THIS CODE")
-	fmt.Println("To modify this code - modify the generator.go file and run the generator.go program")
-	fmt.Println("cd  <repo_root>")
-	fmt.Println("go run generator -version=v12.0 > pkg/linetypes/v12_0/linetypes.go\n*/")
-	fmt.Println("")
-
-	// print some utility funcs
-	fmt.Println(`
-	func GetLeadFromInitValid(data []string, dataFieldIndex int) string {
-		initTime, _ := time.Parse("20060102_150405", data[dataFieldIndex-1])
-		validTime, _ := time.Parse("20060102_150405", data[dataFieldIndex+1])
-		lead := validTime.Sub(initTime)
-		return strconv.FormatInt(int64(lead.Hours()), 10)
-	}
-
-	func SetValueForField(doc *map[string]interface{}, fileType string, term string, i int, dataLen int, fields []string, fieldIndex int, dataType string) {
-		if term == "INIT" && fileType != "TCST" {
-			// do not assign any value to the INIT field for TCST files
-			// The INIT field needs to be moved from the header to the data section.
-			return
+		if _, ok := code.lineTypes[docStructName]; !ok {
+			code.fileLineTypes = append(code.fileLineTypes, docStructName)
 		}
-		if term == "LEAD" && fileType == "TCST" {
-			// This is a special case for TCST files.
-			// if the TERM is LEAD and the lead is NA then we
-			// have to get the lead from the init and valid fields.
-			// INIT is the prior field and VALID is the next field from LEAD.
-			// the init and valid fields are in the format YYYYMMDD_HHMMSS
-			// the lead is the difference between the valid and the init
-			// in hours
-			(*doc)["LEAD"], _ = strconv.Atoi(GetLeadFromInitValid(fields, fieldIndex))
-			return
+		code.lineTypes[docStructName] = lineTypeCode{
+			headerStruct:       headerStructString,
+			fillHeader:         fillHeaderString,
+			dataStruct:         dataStruct,
+			fillData:           fillStructureString,
+			getDocIDCase:       docIDCase,
+			addDataElementCase: addDataElementCase,
+			headerColumns:      headerColumns,
 		}
-		if i <= dataLen && fields[fieldIndex] != "" && fields[fieldIndex] != "NA" {
-			switch dataType {
-			case "int":
-				(*doc)[term], _ = strconv.Atoi(fields[fieldIndex])
-				return
-			case "float64":
-				(*doc)[term], _ = strconv.ParseFloat(fields[fieldIndex], 64)
-				return
-			case "string":
-				(*doc)[term] = fields[fieldIndex]
-				return
-			default:
-				(*doc)[term] = fields[fieldIndex]
-				return
-			}
+	}
+	code.hashComment = sources.hashComment()
+	return code, nil
+}
+
+func getLineTypeSetString(parserVersion string, fileLineTypes []string, headerColumns map[string][]string) string {
+	lineTypeSetString := "// lineTypes are the fileLineTypes that this package supports\nvar lineTypes = []string{\n"
+	for _, fileLineType := range fileLineTypes {
+		lineTypeSetString += fmt.Sprintf("\t%q,\n", fileLineType)
+	}
+	lineTypeSetString += "}\n\n"
+	lineTypeSetString += "// headerColumns are the header columns of every fileLineType in the met_header_columns file\nvar headerColumns = map[string][]string{\n"
+	for _, fileLineType := range fileLineTypes {
+		quoted := make([]string, len(headerColumns[fileLineType]))
+		for i, column := range headerColumns[fileLineType] {
+			quoted[i] = fmt.Sprintf("%q", column)
 		}
-	}`)
-	// print the header structs in order
-	fmt.Println("")
-	fmt.Println("//Header struct definitions")
-	hsKeys := getSortedKeys(headerStructs)
-	for _, key := range hsKeys {
-		fmt.Println(headerStructs[key])
-	}
-	// print the fillHeader functions in order
-	fmt.Println("")
-	fmt.Println("//fillHeader functions")
-	fhKeys := getSortedKeys(fillHeaderFuncs)
-	for _, key := range fhKeys {
-		fmt.Println(fillHeaderFuncs[key])
-	}
-
-	// print the data structs in order
-	fmt.Println("")
-	fmt.Println("//line data struct definitions")
-	dsKeys := getSortedKeys(dataStructs)
-	for _, key := range dsKeys {
-		fmt.Println(dataStructs[key])
-	}
-
-	// print the fillStructure functions in order
-	fmt.Println("")
-	fmt.Println("//fillStructure functions")
-	fdfKeys := getSortedKeys(fillDataFuncs)
-	for _, key := range fdfKeys {
-		// print the function
-		fmt.Println(fillDataFuncs[key])
-	}
-
-	// print the getDocForId functions
-	fmt.Println("")
-	fmt.Println("//getDocForId functions")
-	fmt.Println(docIDString)
-
-	// print the addDataElement functions
-	fmt.Println("")
-	fmt.Println("//addDataElement functions")
-	fmt.Println(addDataElementString)
-
-	// print the DateFieldNames
-	fmt.Println("")
-	fmt.Println("var MetHeaderColumnsFileUrl = \"" + metHeaderColumnsFileUrl + "\"")
-	fmt.Println("")
+		lineTypeSetString += fmt.Sprintf("\t%q: {%s},\n", fileLineType, strings.Join(quoted, ", "))
+	}
+	lineTypeSetString += "}\n\n"
+	lineTypeSetString += "// lineTypeSet is the util.LineTypeSet of this MET version\ntype lineTypeSet struct{}\n\n"
+	lineTypeSetString += "func init() {\n\tutil.RegisterLineTypeSet(lineTypeSet{})\n}\n\n"
+	lineTypeSetString += fmt.Sprintf("func (lineTypeSet) Version() string {\n\treturn %q\n}\n\n", parserVersion)
+	lineTypeSetString += "func (lineTypeSet) GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {\n" +
+		"\treturn GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)\n}\n\n"
+	lineTypeSetString += "func (lineTypeSet) AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {\n" +
+		"\treturn AddDataElement(dataKey, fileLineType, dataData, doc)\n}\n\n"
+	lineTypeSetString += "func (lineTypeSet) ColumnDefsUrl() string {\n\treturn MetHeaderColumnsFileUrl\n}\n\n"
+	lineTypeSetString += "func (lineTypeSet) LineTypes() []string {\n\treturn slices.Clone(lineTypes)\n}\n\n"
+	lineTypeSetString += "func (lineTypeSet) HeaderColumns(fileLineType string) []string {\n\treturn slices.Clone(headerColumns[fileLineType])\n}\n"
+	return lineTypeSetString
 }
 
 // private functions
 
 // returns the keys of the map, sorted alphabetically
-func getSortedKeys(m map[string]string) []string {
+func getSortedKeys[V any](m map[string]V) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
@@ -252,34 +294,11 @@ func getSortedKeys(m map[string]string) []string {
 	return keys
 }
 
-// rewrites strings to camelCase format.
-func toCamelCase(s string) string {
-	// Remove all characters that are not alphanumeric, spaces, hyphens or underscores
-	s = regexp.MustCompile("[^a-zA-Z0-9-_ ]+").ReplaceAllString(s, "")
-
-	// Replace all underscores & hyphens with spaces
-	s = strings.ReplaceAll(s, "_", " ")
-	s = strings.ReplaceAll(s, "-", " ")
-
-	// Title case s. As part of that, switch from UPPER to lower case
-	s = cases.Title(language.AmericanEnglish).String(s)
-
-	// Remove all spaces
-	s = strings.ReplaceAll(s, " ", "")
-
-	// Lowercase the first letter
-	if len(s) > 0 {
-		s = strings.ToLower(s[:1]) + s[1:]
-	}
-
-	return s
-}
-
 func getFileLineType(line string) (string, string, string, error) {
 	parts := strings.Split(line, ": VERSION")
 	if len(parts) < 2 {
 		if line != "" {
-			fmt.Println("error parsing line: met_header_columns" + "line:'" + line + "'")
+			fmt.Fprintln(os.Stderr, "error parsing line: met_header_columns"+"line:'"+line+"'")
 		}
 		return "", "", "", fmt.Errorf("error parsing line: met_header_columns line:'%s'", line)
 	}
@@ -288,7 +307,7 @@ func getFileLineType(line string) (string, string, string, error) {
 	// get the version from the line
 	parts = strings.Split(prefix, " : ")
 	if len(parts) < 3 {
-		fmt.Println("error parsing line: " + line)
+		fmt.Fprintln(os.Stderr, "error parsing line: "+line)
 		return "", "", "", fmt.Errorf("error parsing line: met_header_columns line:'%s'", line)
 	}
 	fileType := strings.ToUpper(strings.TrimSpace(parts[1]))
@@ -358,8 +377,9 @@ func getHeaderStructureString(fileType string, lineType string, getDocIDString s
 		term = strings.ReplaceAll(term, "[0-9]*", "i")
 		name := strings.ToUpper(term)
 		_, dataType := getDataType(term, &metDataTypesForLines)
-		jsonName := toCamelCase(name)
-		headerStructString += fmt.Sprintf("    %-*s %s `json:\"%s\"`\n", padding, name, dataType, jsonName)
+		// the header fields are pointers so that a missing value is nil, and they are marshaled with the MET column name
+		// because that is the name that the header field has in the document, see the parser Document type
+		headerStructString += fmt.Sprintf("    %-*s *%s `json:\"%s,omitempty\"`\n", padding, name, dataType, name)
 		if term == "LINE_TYPE" && (fileType == "MODE" || fileType == "MTD") {
 			// these file types do not have a LINE_TYPE field in the header definition
 			// from the met_header_columns file. We add a LINE_TYPE field to the header struct
@@ -398,6 +418,8 @@ func getFillStructureString(docStructName string, dataFields []string, metDataTy
 	}
 	fillStructureString += "}\n"
 
+	// the parser records the JSON names of the missing (nil) fields of each data entry
+	dataStruct += fmt.Sprintf("    %-*s %-*s `json:\"missing,omitempty\"`\n", padding, "MISSING", padding2, "[]string")
 	dataStruct += "}\n"
 	return fillStructureString, dataStruct
 }
@@ -406,23 +428,28 @@ func getFillStructureTerm(term string, metDataTypesForLines map[string]string, d
 	_filledStructureString := fillStructureString
 	_dataStruct := dataStruct
 	cleanTerm, dataType := getDataType(term, &metDataTypesForLines)
-	jsonTerm := toCamelCase(cleanTerm)
+	jsonTerm := util.ToCamelCase(cleanTerm)
 
-	_dataStruct += fmt.Sprintf("    %-*s %-*s `json:\"%s,omitempty\"`\n", padding, cleanTerm, padding2, dataType, jsonTerm)
+	// the numeric fields are pointers so that a real 0 is kept in the JSON output and a missing ("NA") value is nil
+	fieldType := dataType
+	if dataType == "int" || dataType == "float64" {
+		fieldType = "*" + dataType
+	}
+	_dataStruct += fmt.Sprintf("    %-*s %-*s `json:\"%s,omitempty\"`\n", padding, cleanTerm, padding2, fieldType, jsonTerm)
 	var numFields int
 	var err error
 	var repeatFillStructureString string
 	_filledStructureString += "\ti++; if i <= dataLen {"
 	switch dataType {
 	case "int":
-		_filledStructureString += fmt.Sprintf("s.%s, _ = strconv.Atoi(fields[%d])", cleanTerm, index)
+		_filledStructureString += fmt.Sprintf("s.%s = ParseIntField(fields[%d])", cleanTerm, index)
 	case "float64":
-		_filledStructureString += fmt.Sprintf("s.%s, _ = strconv.ParseFloat(fields[%d], 64)", cleanTerm, index)
+		_filledStructureString += fmt.Sprintf("s.%s = ParseFloatField(fields[%d])", cleanTerm, index)
 	case "map[string]interface{}":
 		// this is a map which means that there are a sequence of fields that are repeated
 		numFields, repeatFillStructureString, err = getRepeatingSequenceStructureString(term, cleanTerm, fileType, lineType, index)
 		if err != nil {
-			fmt.Println("error in getRepeatingSequenceStructureString: ", err)
+			fmt.Fprintln(os.Stderr, "error in getRepeatingSequenceStructureString: ", err)
 		}
 		_filledStructureString += repeatFillStructureString
 		index += numFields
@@ -443,7 +470,8 @@ func getRepeatingSequenceStructureString(term string, cleanTerm string, fileType
 		because there may be single fields remaining in the data line after the repeating fields are swallowed up, and
 		the caller needs to know what the index of those fields is.
 
-		There are several possible repeating fields depending on line and file type.
+		There are several possible repeating fields depending on line and file type. They are defined by the
+		repeatingGroups of the generator config (generator/config.json), these are the ones in the default config.
 			Repeated sequences:
 			Some line types can have repeated sequences of fields.
 			These fields must be represented in the data sections of documents as embedded maps.
@@ -486,9 +514,11 @@ func getRepeatingSequenceStructureString(term string, cleanTerm string, fileType
 			(N_DIAG) for TCDIAG files, the repeated sequence is DIAG_n VALUE_n that will be contained in a map[string]interface{}
 			where the keys are DIAG_n and VALUE_n e.g. DIAG_1, VALUE_1, DIAG_2, VALUE_2 etc.
 	*/
-	fileLineType := fileType + "_" + lineType
-	switch term {
-	case "(N_CAT)":
+	group, sequence, ok := getConfig().repeatingGroup(term, fileType+"_"+lineType)
+	if !ok {
+		return index, "", nil
+	}
+	if group.Layout == "matrix" {
 		/*  MCTC files have a sequence of Fn_On key/values in an n dimensional array of ints.
 		MCTC records - I find these in different file types, e.g. grid_stat_APCP as well as grid_stat...mctc.txt files.
 		The 25th field is the (NCAT) i.e.start of the repeating sequence and the number of dimensions in a n dimensional array.
@@ -498,45 +528,9 @@ func getRepeatingSequenceStructureString(term string, cleanTerm string, fileType
 		The dimensions AND the order must be inferred from the NCAT and the knowledge that they go in sorted
 		order 1st dimension then second dimension. As far as I know these are always ints
 		*/
-		return getNCATStructureString(cleanTerm, index)
-	case "(N_THRESH)": // PCT, PJC, PRC, PSTD, PROBRIRW files
-		switch lineType {
-		case "PCT":
-			// THRESH PCT which is a sequence of THRESH_n OY_n ON_n which are float64 values
-			return getFillStructureSequenceString([]string{"THRESH_", "OY_", "ON_"}, cleanTerm, "float64", index)
-		case "PJC":
-			// PJC files have a sequence of THRESH_n OY_TP_n ON_TP_n CALIBRATION_n REFINEMENT_n LIKELIHOOD_n BASER_n which are float64 values
-			return getFillStructureSequenceString([]string{"THRESH_", "OY_TP_", "ON_TP_", "CALIBRATION_", "REFINEMENT", "LIKELIHOOD_", "BASER_"}, cleanTerm, "float64", index)
-		case "PRC":
-			// PRC files have a sequence of THRESH_n PODY_n POFD_n which are float64 values
-			return getFillStructureSequenceString([]string{"THRESH_", "PODY_", "POFD_"}, cleanTerm, "float64", index)
-		case "PSTD":
-			// PSTD files have a sequence of THRESH_n which are float64 values
-			return getFillStructureSequenceString([]string{"THRESH_"}, cleanTerm, "float64", index)
-		case "PROBRIRW":
-			// PROBRIRW files have a sequence of THRESH_n PROB_n which are int values
-			return getFillStructureSequenceString([]string{"THRESH_", "PROB_"}, cleanTerm, "int", index)
-		}
-
-	case "(N_PTS)": // ECLV files (N_PTS) for ECLV files which is a sequence of CL_n VALUE_n which are float64 values
-		return getFillStructureSequenceString([]string{"CL_", "VALUE_"}, cleanTerm, "float64", index)
-	case "(N_RANK)": // RHIST files (N_RANK) for RHIST files, the repeated sequence is RANK_n which are int values
-		return getFillStructureSequenceString([]string{"RANK_"}, cleanTerm, "int", index)
-	case "(N_BIN)": // PHIST files (N_BIN) for PHIST files, the repeated sequence is BIN_n which are int values
-		return getFillStructureSequenceString([]string{"BIN_"}, cleanTerm, "int", index)
-	case "(N_ENS)": // ORANK, RELP files (N_ENS) for ORANK files, the repeated sequence is ENS_n
-		if fileLineType == "STAT_ORANK" {
-			// (N_ENS) for ORANK files, the repeated sequence is ENS_n which are ints (can have NA values)
-			return getFillStructureSequenceString([]string{"ENS_"}, cleanTerm, "int", index)
-		}
-		if fileLineType == "STAT_RELP" {
-			// (N_ENS) for RELP files, the repeated sequence is RELP_n which are float64 values
-			return getFillStructureSequenceString([]string{"RELP_"}, cleanTerm, "float64", index)
-		}
-	case "(N_DIAG)": // TCDIAG files (no sample data for this type)
-		return getFillStructureSequenceString([]string{"DIAG_", "VALUE_"}, cleanTerm, "string", index)
+		return getNCATStructureString(sequence.KeyPrefixes, cleanTerm, index)
 	}
-	return index, "", nil
+	return getFillStructureSequenceString(sequence.KeyPrefixes, cleanTerm, sequence.ElementType, index)
 }
 
 func getFillStructureSequenceString(keyPrefixes []string, cleanTerm string, elemType string, index int) (numFields int, structureString string, err error) {
@@ -546,16 +540,19 @@ func getFillStructureSequenceString(keyPrefixes []string, cleanTerm string, elem
 	switch elemType {
 	case "float64":
 		convStr = `value, err = strconv.ParseFloat(fields[index],64)
-					if err != nil { // sometimes there can be these NA values in the data, which will be left out of json
-						value = "NA"
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
 					}`
 	case "int":
 		convStr = `value, err = strconv.Atoi(fields[index])
-					if err != nil { // sometimes there can be these NA values in the data, which will be left out of json
-						value = "NA"
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
 					}`
 	default:
-		convStr = "value = fields[index]"
+		convStr = `value = fields[index]
+					if value == "NA" { // a missing value is nil
+						value = nil
+					}`
 	}
 	str := `    // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
 	var value interface{}
@@ -568,8 +565,8 @@ func getFillStructureSequenceString(keyPrefixes []string, cleanTerm string, elem
 	for group := 1; group <= count; group++ {
 		for index := %d; index <= len(keyPrefixes); index++ {
 			key := fmt.Sprintf("%%s_%%d",keyPrefixes[index-1], index)
-			if index > len(fields) { // sometimes the data line is truncated - we will set expected data to "NA"
-				value = "NA"
+			if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+				value = nil
 			} else {
 				%s
 			}
@@ -580,9 +577,9 @@ func getFillStructureSequenceString(keyPrefixes []string, cleanTerm string, elem
 	return len(keyPrefixes), str, nil
 }
 
-func getNCATStructureString(cleanTerm string, index int) (numFields int, structureString string, err error) {
-	// these values seem to always be ints (or "NA")
-	str := `    // these values seem to always be ints (or "NA")
+func getNCATStructureString(keyPrefixes []string, cleanTerm string, index int) (numFields int, structureString string, err error) {
+	// these values seem to always be ints (or "NA" which is a missing value i.e. nil)
+	str := `    // these values seem to always be ints (or "NA" which is a missing value i.e. nil)
 	var value interface{}
 	count, err := strconv.Atoi(fields[1])
 	if err != nil {
@@ -592,30 +589,33 @@ func getNCATStructureString(cleanTerm string, index int) (numFields int, structu
 	for i1 := %d; i1 <= count; i1++ {
 		for i2 := 1; i2 <= count; i2++ {
 			// generate the particular key for the map i.e. F1_O1, F1_O2, F1_O3, F1_O4, F2_O1, F2_O2, F2_O3, F2_O4, etc.
-			key := fmt.Sprintf("F%%d_O%%d", i1, i2)
+			key := fmt.Sprintf("%s%%d_%s%%d", i1, i2)
 			index := (i1-1)*count + i2
 			if index >= len(fields) {
-				value = "NA"
+				value = nil
 			} else {
 				value, err = strconv.Atoi(fields[index])
 			}
 			if err != nil {
-				value = "NA"
+				value = nil
 			}
 			s.%s[key] = value
 		}` + "\n\t}\n"
 
-	str = fmt.Sprintf(str, cleanTerm, index, cleanTerm)
+	str = fmt.Sprintf(str, cleanTerm, index, keyPrefixes[0], keyPrefixes[1], cleanTerm)
 	return 1, str, nil
 }
 
-func fillMetDataMapFromSrcFiles(metDataTypesForLines map[string]string, fieldNameMap map[string]string) map[string]string {
+func fillMetDataMapFromSrcFiles(metDataTypesForLines map[string]string, fieldNameMap map[string]string) (map[string]string, error) {
 	// use a map (atoLines) as a set to avoid duplicate lines
 	atoLines := make(map[string]bool)
 	// iterate through the metSrcFiles to get the data types for the fields
 	matchConvertLine := regexp.MustCompile(`= ato[fi]\(l.get_item\(`)
 	for _, url := range metSrcFiles {
-		lines := getLinesForUrl(url)
+		lines, err := getLinesForUrl(url)
+		if err != nil {
+			return nil, err
+		}
 		// iterate through the lines to find the data types
 		for _, line := range lines {
 			parts := matchConvertLine.Split(line, -1)
@@ -647,10 +647,10 @@ func fillMetDataMapFromSrcFiles(metDataTypesForLines map[string]string, fieldNam
 			}
 		}
 	}
-	return metDataTypesForLines
+	return metDataTypesForLines, nil
 }
 
-func fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap map[string]string) map[string]string {
+func fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap map[string]string) (map[string]string, error) {
 	// MET user guide files with data type definitions
 	// Using the slower regexp instead of a string match because I don't know if the line will have
 	// extra leading spaces or not. These documents might get reformatted and the leading spaces might
@@ -660,7 +660,10 @@ func fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap map[string]s
 	// The regexp to identify the start of a column header
 	lineColumnStart := regexp.MustCompile(`^\s*\* - Column`)
 	for _, url := range metUserDocFiles {
-		docFileLines := getLinesForUrl(url)
+		docFileLines, err := getLinesForUrl(url)
+		if err != nil {
+			return nil, err
+		}
 		var parts []string
 		for i := 0; i < len(docFileLines)-1; i++ {
 			line := docFileLines[i]
@@ -790,53 +793,27 @@ func fillMetDataMapFromUserGuide(metDataTypesForLines, fieldNameMap map[string]s
 	// NOTE: These will overwrite any previous data types for specific named fields that were found in the MET source files.
 	metDataTypesForLines, _ = overRideDefinedMetDataTypes(metDataTypesForLines, fieldNameMap)
 
-	return metDataTypesForLines
+	return metDataTypesForLines, nil
 }
 
 // overRideDefinedMetDataTypes is used to manually override the data types for specific fields that are defined in, or missing from the MET source
 // code & documentation that we reference.
 func overRideDefinedMetDataTypes(metDataTypesForLines map[string]string, fieldNameMap map[string]string) (map[string]string, map[string]string) {
-	metDataTypesForLines["RIRW_WINDOW"] = "int"
-	metDataTypesForLines["F[0-9]*_O[0-9]*"] = "string"
-	metDataTypesForLines["INTENSITY_USER"] = "float64"
-	metDataTypesForLines["INTENSITY_USER_MIN"] = "float64"
-	metDataTypesForLines["INTENSITY_USER_MAX"] = "float64"
-	metDataTypesForLines["RPS_COMP"] = "float64"
-	metDataTypesForLines["ARADP"] = "string"
-	metDataTypesForLines["AMRD"] = "int"
-	metDataTypesForLines["AGUSTS"] = "int"
-	metDataTypesForLines["ADIR"] = "int"
-	metDataTypesForLines["AEYE"] = "int"
-	metDataTypesForLines["EIQR_BCL"] = "float64"
-	metDataTypesForLines["EIQR_BCU"] = "float64"
-	metDataTypesForLines["ASPEED"] = "int"
-	metDataTypesForLines["ARRP"] = "int"
-	metDataTypesForLines["ADEPTH"] = "int"
-
-	fieldNameMap["RIRW_WINDOW"] = "int"
-	fieldNameMap["F[0-9]*_O[0-9]*"] = "string"
-	fieldNameMap["INTENSITY_USER"] = "float64"
-	fieldNameMap["INTENSITY_USER_MIN"] = "float64"
-	fieldNameMap["INTENSITY_USER_MAX"] = "float64"
-	fieldNameMap["RPS_COMP"] = "float64"
-	fieldNameMap["ARADP"] = "string"
-	fieldNameMap["AMRD"] = "int"
-	fieldNameMap["AGUSTS"] = "int"
-	fieldNameMap["ADIR"] = "int"
-	fieldNameMap["AEYE"] = "int"
-	fieldNameMap["EIQR_BCL"] = "float64"
-	fieldNameMap["EIQR_BCU"] = "float64"
-	fieldNameMap["ASPEED"] = "int"
-	fieldNameMap["ARRP"] = "int"
-	fieldNameMap["ADEPTH"] = "int"
-
-	// Uncomment the following to look for missing data types in the MET user guide files.
+	for field, fieldType := range getConfig().fieldTypes(metParserVersion) {
+		metDataTypesForLines[field] = fieldType
+		fieldNameMap[field] = fieldType
+	}
+	return metDataTypesForLines, fieldNameMap
+}
+
+// getUndefinedDataTypesComment returns a comment that lists the fields that have no data type in the MET inputs or the generator config, or "".
+func getUndefinedDataTypesComment(fieldNameMap map[string]string) string {
 	var found bool
 	undefineds := []string{}
 	for k, v := range fieldNameMap {
 		if v == "UNDEFINED" {
 			found = false
-			for _, v1 := range *getPatterns() {
+			for _, v1 := range getPatterns() {
 				if v1.match.MatchString(strings.ToUpper(k)) {
 					found = true
 					break
@@ -847,9 +824,11 @@ func overRideDefinedMetDataTypes(metDataTypesForLines map[string]string, fieldNa
 			}
 		}
 	}
-	if len(undefineds) > 0 {
-		slices.Sort(undefineds)
-		fmt.Printf(`
+	if len(undefineds) == 0 {
+		return ""
+	}
+	slices.Sort(undefineds)
+	return fmt.Sprintf(`
 /*
 The following data types were not found in the MET user guide files or the MET source code files.
 For simplicity, the values of these data types will be treated as strings in the generated code.
@@ -857,14 +836,12 @@ For simplicity, the values of these data types will be treated as strings in the
     Undefined data types: %v
 
 To resolve this, consult the github.com/dtcenter/MET repo to determine if there is a more appropriate type, 
-and, if there is, add an override to the overRideDefinedMetDataTypes function in generator/generator.go.
+and, if there is, add it to the fieldTypes (or versionFieldTypes) of the generator config, generator/config.json.
 */
 `, undefineds)
-	}
-	return metDataTypesForLines, fieldNameMap
 }
 
-func getPatterns() *map[string]Pattern {
+func getPatterns() []Pattern {
 	// These are Regular expression patterns that are used to find the data types for the fields that are not simple,
 	// linear or not found in the user guide files.
 	// PROBRIRW example
@@ -887,56 +864,34 @@ func getPatterns() *map[string]Pattern {
 		// but cl_n values are float64s.
 		// structField is for repeating fields only. These patterns are surrounded by "()" i.e. (N_CAT).
 		// The structure generator needs to know what it is that is repeating. Most likely a map of some sort.
+		// The repeating patterns are the repeatingGroups and the single patterns are the patterns of the generator config,
+		// in the order of the config.
 
 		// repeating patterns
-		patterns["(nCat)"] = Pattern{match: regexp.MustCompile(`(N_CAT)`), dType: "int", structField: "CAT", structType: "map[string]interface{}"}
-		patterns["(nThresh)"] = Pattern{match: regexp.MustCompile(`(N_THRESH)`), dType: "int", structField: "THRESH", structType: "map[string]interface{}"}
-		patterns["(nPts)"] = Pattern{match: regexp.MustCompile(`(N_PTS)`), dType: "int", structField: "PTS", structType: "map[string]interface{}"}
-		patterns["(nEns)"] = Pattern{match: regexp.MustCompile(`(N_ENS)`), dType: "int", structField: "ENS", structType: "map[string]interface{}"}
-		patterns["(nRank)"] = Pattern{match: regexp.MustCompile(`(N_RANK)`), dType: "int", structField: "RANK", structType: "map[string]interface{}"}
-		patterns["(nBin)"] = Pattern{match: regexp.MustCompile(`(N_BIN)`), dType: "int", structField: "BIN", structType: "map[string]interface{}"}
-		patterns["(nDiag)"] = Pattern{match: regexp.MustCompile(`(N_DIAG)`), dType: "int", structField: "DIAG", structType: "map[string]interface{}"}
+		for _, group := range getConfig().RepeatingGroups {
+			patterns = append(patterns, Pattern{match: regexp.MustCompile(group.Term), dType: group.CountType, structField: group.StructField, structType: "map[string]interface{}"})
+		}
 		// single patterns
-		patterns["baserN"] = Pattern{match: regexp.MustCompile("BASER_[0-9]*"), dType: "float64", structField: "BASER_I", structType: "map[string]interface{}"}
-		patterns["binN"] = Pattern{match: regexp.MustCompile("BIN_[0-9]*"), dType: "int", structField: "BIN_I", structType: "int"}
-		patterns["calibrationN"] = Pattern{match: regexp.MustCompile("CALIBRATION_[0-9]*"), dType: "float64", structField: "CALIBRATION_I", structType: "float64"}
-		patterns["clN"] = Pattern{match: regexp.MustCompile("CL_[0-9]*"), dType: "float64", structField: "CL_I", structType: "float64"}
-		patterns["diagN"] = Pattern{match: regexp.MustCompile("DIAG_[0-9]*"), dType: "float64", structField: "DIAG_I", structType: "float64"}
-		patterns["ensN"] = Pattern{match: regexp.MustCompile("ENS_[0-9]*"), dType: "int", structField: "ENS_I", structType: "int"}
-		patterns["fiOi"] = Pattern{match: regexp.MustCompile("F[0-9]*_O[0-9]*"), dType: "string", structField: "FI_OI", structType: "string"}
-		patterns["azfiAzoi"] = Pattern{match: regexp.MustCompile("[A-Z]F[0-9]*_[A-Z]O[0-9]*"), dType: "string", structField: "AZFI_AZOI", structType: "string"}
-		patterns["likelihoodN"] = Pattern{match: regexp.MustCompile("LIKELIHOOD_[0-9]*"), dType: "float64", structField: "LIKELIHOOD_I", structType: "float64"}
-		patterns["aalWindN"] = Pattern{match: regexp.MustCompile("AAL_WIND_[0-9]*"), dType: "float64", structField: "AAL_WIND_I", structType: "float64"}
-		patterns["aseWindN"] = Pattern{match: regexp.MustCompile("ASE_WIND_[0-9]*"), dType: "float64", structField: "ASE_WIND_I", structType: "float64"}
-		patterns["aswWindN"] = Pattern{match: regexp.MustCompile("ASW_WIND_[0-9]*"), dType: "float64", structField: "ASW_WIND_I", structType: "float64"}
-		patterns["aneWindN"] = Pattern{match: regexp.MustCompile("ANE_WIND_[0-9]*"), dType: "float64", structField: "ANE_WIND_I", structType: "float64"}
-		patterns["anwWindN"] = Pattern{match: regexp.MustCompile("ANW_WIND_[0-9]*"), dType: "float64", structField: "ANW_WIND_I", structType: "float64"}
-		patterns["onTpN"] = Pattern{match: regexp.MustCompile("ON_TP_[0-9]*"), dType: "float64", structField: "ON_TP_I", structType: "float64"}
-		patterns["onN"] = Pattern{match: regexp.MustCompile("ON_[0-9]*"), dType: "float64", structField: "ON_I", structType: "float64"}
-		patterns["oyTpN"] = Pattern{match: regexp.MustCompile("OY_TP_[0-9]*"), dType: "float64", structField: "OY_TP_I", structType: "float64"}
-		patterns["oyN"] = Pattern{match: regexp.MustCompile("OY_[0-9]*"), dType: "float64", structField: "OY_I", structType: "float64"}
-		patterns["podyN"] = Pattern{match: regexp.MustCompile("PODY_[0-9]*"), dType: "float64", structField: "PODY_I", structType: "float64"}
-		patterns["pofdN"] = Pattern{match: regexp.MustCompile("POFD_[0-9]*"), dType: "float64", structField: "POFD_I", structType: "float64"}
-		patterns["probN"] = Pattern{match: regexp.MustCompile("PROB_[0-9]*"), dType: "float64", structField: "PROB_I", structType: "float64"}
-		patterns["rankN"] = Pattern{match: regexp.MustCompile("RANK_[0-9]*"), dType: "int", structField: "RANK_I", structType: "int"}
-		patterns["refinementN"] = Pattern{match: regexp.MustCompile("REFINEMENT_[0-9]*"), dType: "float64", structField: "REFINEMENT_I", structType: "float64"}
-		patterns["relpN"] = Pattern{match: regexp.MustCompile("RELP_[0-9]*"), dType: "float64", structField: "RELP_I", structType: "float64"}
-		patterns["threshN"] = Pattern{match: regexp.MustCompile("THRESH_[0-9]*"), dType: "int", structField: "THRESH_I", structType: "int"}
-		patterns["valueN"] = Pattern{match: regexp.MustCompile("VALUE_[0-9]*"), dType: "int", structField: "VALUE_I", structType: "int"}
-	}
-	return &patterns
+		for _, pattern := range getConfig().Patterns {
+			patterns = append(patterns, Pattern{match: regexp.MustCompile(pattern.Match), dType: pattern.Type, structField: pattern.StructField, structType: pattern.StructType})
+		}
+	}
+	return patterns
 }
 
-func getColumnLinesAndMapForUrl(fileUrl string) ([]string, map[string]string) {
+func getColumnLinesAndMapForUrl(fileUrl string) ([]string, map[string]string, error) {
 	fieldNameMap := make(map[string]string)
-	lines := getLinesForUrl(fileUrl)
+	lines, err := getLinesForUrl(fileUrl)
+	if err != nil {
+		return nil, nil, err
+	}
 	// split out all the fields to get a map of required fields
 	for _, line := range lines {
 		// get the prefix from the line
 		parts := strings.Split(line, ": VERSION")
 		if len(parts) < 2 {
 			if line != "" {
-				fmt.Println("error parsing line: met_header_columns" + "line:'" + line + "'")
+				fmt.Fprintln(os.Stderr, "error parsing line: met_header_columns"+"line:'"+line+"'")
 			}
 			continue
 		}
@@ -951,23 +906,17 @@ func getColumnLinesAndMapForUrl(fileUrl string) ([]string, map[string]string) {
 			fieldNameMap[strings.ToUpper(name)] = "UNDEFINED"
 		}
 	}
-	return lines, fieldNameMap
+	return lines, fieldNameMap, nil
 }
 
-func getLinesForUrl(fileUrl string) []string {
-	resp, err := http.Get(fileUrl)
+// getLinesForUrl returns the lines of a MET file, from the source directory or downloaded, see sourceSet.
+func getLinesForUrl(fileUrl string) ([]string, error) {
+	rawBytes, err := sources.read(fileUrl)
 	if err != nil {
-		fmt.Println("error getting met_header_columns file" + err.Error())
-		os.Exit(1)
-	}
-	defer resp.Body.Close()
-	rawColumnsBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		// Using the header definitions in the appropriate version of data/table_files/met_header_columns_X.X.txt
-		os.Exit(1)
+		return nil, err
 	}
-	lines := strings.Split(string(rawColumnsBytes), "\n")
-	return lines
+	lines := strings.Split(string(rawBytes), "\n")
+	return lines, nil
 }
 
 func getDataType(name string, metDataTypesLines *map[string]string) (key string, dType string) {
@@ -980,13 +929,13 @@ func getDataType(name string, metDataTypesLines *map[string]string) (key string,
 	//uName := cases.Title(language.English).String(name)
 	uName := strings.ToUpper(name)
 	// is it exactly the same as a pattern? This is the case for a structure repeating field.
-	for _, v := range *getPatterns() {
+	for _, v := range getPatterns() {
 		if v.match.String() == uName {
 			return v.structField, v.structType
 		}
 	}
 	// does it match a pattern? This is the case for a structure simple field.
-	for _, v := range *getPatterns() {
+	for _, v := range getPatterns() {
 		if v.match.MatchString(uName) {
 			return uName, v.dType
 		}
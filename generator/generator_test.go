@@ -1,41 +1,18 @@
 package main
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-)
-
-func TestToCamelCase(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-	}{
-		{"FCST_VALID", "fcstValid"},
-		{"VERSION", "version"},
-		{"N_VALID", "nValid"},
-		{"STORM_ID", "stormId"},
-		{"LINE_TYPE", "lineType"},
-		{"INIT_MASK", "initMask"},
-		{"N_CAT", "nCat"},
-		{"DIAG_N", "diagN"},
-		{"", ""},
-		{"A", "a"},
-		{"FOO_BAR_BAZ", "fooBarBaz"},
-		{"FOO__BAR", "fooBar"},
-		{"FOO BAR", "fooBar"},
-		{"FOO-BAR", "fooBar"},
-		{"INIT_12345", "init12345"},
-		{"F1_O2", "f1O2"},
-	}
 
-	for _, test := range tests {
-		t.Run(test.input, func(t *testing.T) {
-			result := toCamelCase(test.input)
-			assert.Equal(t, test.expected, result, "toCamelCase(%q) should return %q", test.input, test.expected)
-		})
-	}
-}
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
 
 func TestGetSortedKeys(t *testing.T) {
 	tests := []struct {
@@ -106,3 +83,453 @@ func TestGetSortedKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFillStructureTermNumericPointers(t *testing.T) {
+	metDataTypesForLines := map[string]string{"TOTAL": "int", "FBAR": "float64", "FCST_VAR": "string"}
+	tests := []struct {
+		term       string
+		fieldType  string
+		fillString string
+	}{
+		{"TOTAL", "*int", "s.TOTAL = ParseIntField(fields[0])"},
+		{"FBAR", "*float64", "s.FBAR = ParseFloatField(fields[0])"},
+		{"FCST_VAR", "string", "s.FCST_VAR = fields[0]"},
+	}
+	for _, test := range tests {
+		t.Run(test.term, func(t *testing.T) {
+			fillString, dataStruct, _ := getFillStructureTerm(test.term, metDataTypesForLines, "", 8, 8, "", 0, "STAT", "CNT")
+			assert.Regexp(t, test.term+` +`+regexp.QuoteMeta(test.fieldType)+` +`+"`json:\"\\w+,omitempty\"`", dataStruct)
+			assert.Contains(t, fillString, test.fillString)
+		})
+	}
+}
+
+func TestGetHeaderStructureStringPointers(t *testing.T) {
+	metDataTypesForLines := map[string]string{"MODEL": "string", "INTERP_PNTS": "int", "ALPHA": "float64"}
+	headerFields := []string{"VERSION", "MODEL", "DESC", "FCST_LEAD", "INTERP_PNTS", "ALPHA", "LINE_TYPE"}
+	_, headerStructName, headerStruct, _, _, _ := getHeaderStructureString("STAT", "CNT", "", "", headerFields, metDataTypesForLines)
+	assert.Equal(t, "STAT_CNT_header", headerStructName)
+	assert.Regexp(t, `MODEL +\*string +`+"`json:\"MODEL,omitempty\"`", headerStruct)
+	assert.Regexp(t, `INTERP_PNTS +\*int +`+"`json:\"INTERP_PNTS,omitempty\"`", headerStruct)
+	assert.Regexp(t, `ALPHA +\*float64 +`+"`json:\"ALPHA,omitempty\"`", headerStruct)
+	// the dataKey is not in the header
+	assert.NotContains(t, headerStruct, "FCST_LEAD")
+}
+
+func TestGetLineTypeSetString(t *testing.T) {
+	headerColumns := map[string][]string{"STAT_CNT": {"VERSION", "MODEL", "LINE_TYPE"}, "STAT_CTC": {"VERSION", "MODEL", "LINE_TYPE"}}
+	lineTypeSetString := getLineTypeSetString("v12_0", []string{"STAT_CNT", "STAT_CTC"}, headerColumns)
+	assert.Contains(t, lineTypeSetString, "\"STAT_CNT\": {\"VERSION\", \"MODEL\", \"LINE_TYPE\"},\n")
+	assert.Contains(t, lineTypeSetString, "\"STAT_CNT\",\n\t\"STAT_CTC\",\n")
+	assert.Contains(t, lineTypeSetString, "util.RegisterLineTypeSet(lineTypeSet{})")
+	assert.Contains(t, lineTypeSetString, "return \"v12_0\"")
+}
+
+func TestSetMetVersion(t *testing.T) {
+	assert.NoError(t, setMetVersion("v11_1"))
+	assert.Equal(t, util.MetHeaderColumnsFileUrl_v11_1, metHeaderColumnsFileUrl)
+	err := setMetVersion("v9_0")
+	assert.ErrorContains(t, err, "supported are v10_0, v10_1, v11_0, v11_1, v12_0")
+}
+
+func TestSourcePath(t *testing.T) {
+	path, err := sourcePath(util.MetHeaderColumnsFileUrl_v11_0)
+	assert.NoError(t, err)
+	assert.Equal(t, "data/table_files/met_header_columns_V11.0.txt", path)
+	path, err = sourcePath("https://raw.githubusercontent.com/dtcenter/MET/refs/heads/main_v12.0/docs/Users_Guide/mode.rst")
+	assert.NoError(t, err)
+	assert.Equal(t, "docs/Users_Guide/mode.rst", path)
+	_, err = sourcePath("https://example.com/met_header_columns_V12.0.txt")
+	assert.Error(t, err)
+}
+
+func TestSourceSetReadsSourceDir(t *testing.T) {
+	dir := t.TempDir()
+	tableDir := filepath.Join(dir, "data", "table_files")
+	assert.NoError(t, os.MkdirAll(tableDir, 0o755))
+	content := "V12.0 : STAT : FHO : VERSION MODEL DESC LINE_TYPE TOTAL F_RATE H_RATE O_RATE\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(tableDir, "met_header_columns_V12.0.txt"), []byte(content), 0o644))
+	s := &sourceSet{dir: dir}
+	// reading a file twice records it once
+	for range 2 {
+		got, err := s.read(util.MetHeaderColumnsFileUrl_v12_0)
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(got))
+	}
+	assert.Equal(t, "Inputs (sha256):\n"+
+		"7ee42070de2d92e449f03afe07166258216d5b819f296ac38c8b468204e34e8b data/table_files/met_header_columns_V12.0.txt\n", s.hashComment())
+	_, err := s.read(util.MetHeaderColumnsFileUrl_v11_0)
+	assert.ErrorContains(t, err, "met_header_columns_V11.0.txt")
+}
+
+func TestGeneratorConfig(t *testing.T) {
+	cfg, err := parseConfig(defaultConfigJson)
+	assert.NoError(t, err)
+	assert.Equal(t, "int", cfg.fieldTypes("v12_0")["AMRD"])
+	_, sequence, ok := cfg.repeatingGroup("(N_THRESH)", "STAT_PCT")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"THRESH_", "OY_", "ON_"}, sequence.KeyPrefixes)
+	_, sequence, ok = cfg.repeatingGroup("(N_THRESH)", "TCST_PROBRIRW")
+	assert.True(t, ok)
+	assert.Equal(t, "int", sequence.ElementType)
+	_, _, ok = cfg.repeatingGroup("(N_THRESH)", "STAT_CNT")
+	assert.False(t, ok)
+	group, _, ok := cfg.repeatingGroup("(N_CAT)", "STAT_MCTC")
+	assert.True(t, ok)
+	assert.Equal(t, "matrix", group.Layout)
+	assert.Equal(t, "RANK", cfg.ColumnSpellings[0].Column)
+
+	// a version field type overrides the field type of every version
+	cfg.VersionFieldTypes = map[string]map[string]string{"v12_0": {"AMRD": "float64"}}
+	assert.Equal(t, "float64", cfg.fieldTypes("v12_0")["AMRD"])
+	assert.Equal(t, "int", cfg.fieldTypes("v11_1")["AMRD"])
+
+	for _, invalid := range []string{
+		`{"configVersion": 2}`,
+		`{"configVersion": 1, "fieldTypes": {"AMRD": "integer"}}`,
+		`{"configVersion": 1, "repeatingGroups": [{"term": "N_LEVEL", "structField": "LEVEL", "sequences": [{"keyPrefixes": ["LEVEL_"], "elementType": "int"}]}]}`,
+		`{"configVersion": 1, "repeatingGroups": [{"term": "(N_LEVEL)", "structField": "LEVEL", "sequences": [{"keyPrefixes": [], "elementType": "int"}]}]}`,
+		`{"configVersion": 1, "patterns": [{"match": "LEVEL_[0-9*", "type": "int"}]}`,
+		`{"configVersion": 1, "columnSpellings": [{"columns": ["(N_RANK)", "RANK_[0-9]*"], "column": "RANK"}]}`,
+	} {
+		_, err := parseConfig([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestGeneratorConfigRepeatingGroup(t *testing.T) {
+	cfg, err := parseConfig([]byte(`{
+		"configVersion": 1,
+		"repeatingGroups": [{"term": "(N_LEVEL)", "countType": "int", "structField": "LEVEL",
+			"sequences": [{"lineTypes": ["STAT_TEST"], "keyPrefixes": ["LEVEL_", "HEIGHT_"], "elementType": "float64"}]}],
+		"patterns": [{"match": "LEVEL_[0-9]*", "type": "float64", "structField": "LEVEL_I", "structType": "float64"}]
+	}`))
+	assert.NoError(t, err)
+	defer setConfig(nil)
+	setConfig(cfg)
+	name, dataType := getDataType("(N_LEVEL)", &map[string]string{})
+	assert.Equal(t, "LEVEL", name)
+	assert.Equal(t, "map[string]interface{}", dataType)
+	numFields, fillString, err := getRepeatingSequenceStructureString("(N_LEVEL)", "LEVEL", "STAT", "TEST", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numFields)
+	assert.Contains(t, fillString, `keyPrefixes := []string{"LEVEL_","HEIGHT_"}`)
+	assert.Contains(t, fillString, "strconv.ParseFloat")
+}
+
+func TestColumnObservation(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{values: []string{"NA", ""}, want: ""},
+		{values: []string{"1", "NA", "-12"}, want: "int"},
+		{values: []string{"1", "2.5"}, want: "float64"},
+		{values: []string{"1", "2.5", "TS"}, want: "string"},
+		{values: []string{"02", "11"}, want: "string"},
+		{values: []string{"0", "-1e3"}, want: "float64"},
+	}
+	for _, tt := range tests {
+		var observation columnObservation
+		for _, value := range tt.values {
+			observation.observe(value)
+		}
+		assert.Equal(t, tt.want, observation.inferredType(), tt.values)
+	}
+}
+
+func TestInferCorpusTypes(t *testing.T) {
+	columnLines, err := util.ColumnDefs("v12_0")
+	assert.NoError(t, err)
+	dir := t.TempDir()
+	headerLine := "VERSION AMODEL BMODEL DESC STORM_ID BASIN CYCLONE STORM_NAME INIT LEAD VALID INIT_MASK VALID_MASK LINE_TYPE TOTAL INDEX LEVEL WATCH_WARN INITIALS ALAT ALON BLAT BLON"
+	dataLines := []string{
+		"V12.0.0 GFSO BEST NA AL022023 AL 02 BRET 20230609_120000 000000 20230609_120000 NA NA TCMPR 2 1 TS NA NA 11.4 -43.7 11.3 -43.6",
+		"V12.0.0 GFSO BEST NA AL022023 AL 02 BRET 20230609_120000 060000 20230609_180000 NA NA TCMPR 2 2 TS NA NA 11.7 -45 NA -44",
+	}
+	content := headerLine + "\n" + strings.Join(dataLines, "\n") + "\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "tc_pairs_al02.tcst"), []byte(content), 0o644))
+	corpus, err := inferCorpusTypes(dir, "v12_0", columnLines)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, corpus.lines)
+	assert.Equal(t, "float64", corpus.columns["ALAT"].inferredType())
+	assert.Equal(t, "int", corpus.columns["INDEX"].inferredType())
+	assert.Equal(t, "string", corpus.columns["CYCLONE"].inferredType())
+
+	// ALAT, ALON and BLAT have no documented type, BLON is documented as an int and INDEX as a string
+	metDataTypes := map[string]string{"BLON": "int", "INDEX": "string", "TOTAL": "int"}
+	fieldNameMap := map[string]string{"ALAT": "UNDEFINED", "ALON": "UNDEFINED", "BLAT": "UNDEFINED", "BLON": "int", "CYCLONE": "UNDEFINED"}
+	findings := corpus.findTypes(metDataTypes, fieldNameMap)
+	proposed := map[string]string{}
+	var columns []string
+	for _, finding := range findings {
+		columns = append(columns, finding.column)
+		if finding.proposed != "" {
+			proposed[finding.column] = finding.proposed
+		}
+	}
+	assert.Contains(t, columns, "INDEX")
+	assert.NotContains(t, columns, "CYCLONE")
+	assert.NotContains(t, columns, "TOTAL")
+	assert.Equal(t, "float64", proposed["ALAT"])
+	assert.Equal(t, "float64", proposed["ALON"])
+	assert.Equal(t, "float64", proposed["BLAT"])
+	// an int column with float values
+	assert.Equal(t, "float64", proposed["BLON"])
+	// a string column with int values is reported without a proposal
+	assert.NotContains(t, proposed, "INDEX")
+	report, err := inferenceReport(dir, "v12_0", corpus, findings)
+	assert.NoError(t, err)
+	assert.Contains(t, report, "from 2 data lines")
+	assert.Contains(t, report, "\"ALAT\": \"float64\"")
+}
+
+func TestDiffLineTypeColumns(t *testing.T) {
+	committed, err := readLineTypeColumns([]byte(`package v12_0
+
+type STAT_CNT_header struct {
+	MODEL *string `+"`json:\"MODEL,omitempty\"`"+`
+}
+type STAT_CNT struct {
+	TOTAL   *int
+	FBAR    *float64
+	OLD     *float64
+	MISSING []string
+}
+type STAT_FHO_header struct{}
+type STAT_FHO struct{}
+type lineTypeSet struct{}
+`), nil)
+	assert.NoError(t, err)
+	generated, err := readLineTypeColumns([]byte(`package v12_0
+
+type STAT_CNT_header struct {
+	MODEL *string
+	DESC  *string
+}
+type STAT_CNT struct {
+	TOTAL   *int
+	FBAR    string
+	NEW     *float64
+	MISSING []string
+}
+type STAT_CTC_header struct{}
+type STAT_CTC struct{}
+`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"STAT_CNT: added header column DESC *string",
+		"STAT_CNT: retyped data column FBAR *float64 -> string",
+		"STAT_CNT: added data column NEW *float64",
+		"STAT_CNT: removed data column OLD *float64",
+		"added line type STAT_CTC",
+		"removed line type STAT_FHO",
+	}, diffLineTypeColumns(committed, generated))
+	assert.Empty(t, diffLineTypeColumns(committed, committed))
+
+	// the aliases of a version package are resolved in the package that they alias
+	aliased, err := readLineTypeColumns([]byte(`package v10_1
+
+type (
+	STAT_ECNT        = v10_0.STAT_ECNT
+	STAT_ECNT_header = v10_0.STAT_ECNT_header
+	STAT_FHO         = base.STAT_FHO
+	STAT_FHO_header  = base.STAT_FHO_header
+)
+`), map[string][]byte{basePackage: []byte(`package base
+
+type STAT_FHO_header struct {
+	MODEL *string
+}
+type STAT_FHO struct {
+	TOTAL *int
+}
+type STAT_ECNT_header struct{}
+type STAT_ECNT struct{}
+`), "v10_0": []byte(`package v10_0
+
+type STAT_ECNT_header struct {
+	MODEL *string
+}
+type STAT_ECNT struct {
+	CRPS *float64
+}
+`)})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]lineTypeColumns{
+		"STAT_ECNT": {header: map[string]string{"MODEL": "*string"}, data: map[string]string{"CRPS": "*float64"}},
+		"STAT_FHO":  {header: map[string]string{"MODEL": "*string"}, data: map[string]string{"TOTAL": "*int"}},
+	}, aliased)
+}
+
+func TestColumnTypeTable(t *testing.T) {
+	src := []byte(`package v12_0
+
+type STAT_PCT_header struct {
+	MODEL          *string
+	FCST_VALID_BEG *int
+}
+type STAT_PCT struct {
+	TOTAL   *int
+	THRESH  map[string]interface{}
+	MISSING []string
+}
+type STAT_CNT_header = base.STAT_CNT_header
+type STAT_CNT = base.STAT_CNT
+`)
+	baseSrc := []byte(`package base
+
+type STAT_CNT_header struct {
+	MODEL *string
+}
+type STAT_CNT struct {
+	TOTAL *int
+	FBAR  *float64
+	OBAR  string
+}
+`)
+	table, err := columnTypeTable("v12_0", map[string][]byte{"v12_0": src, basePackage: baseSrc})
+	assert.NoError(t, err)
+	assert.Equal(t, "v12_0", table.Version)
+	// the maps of the repeated sequences are in the repeating groups
+	assert.Equal(t, map[string]string{"MODEL": "string", "FCST_VALID_BEG": "int", "TOTAL": "int", "FBAR": "float64", "OBAR": "string"}, table.ColumnTypes)
+	assert.Equal(t, getConfig().RepeatingGroups, table.RepeatingGroups)
+
+	// a column has one type in a version
+	_, err = columnTypeTable("v12_0", map[string][]byte{"v12_0": append(src, []byte("type STAT_FHO_header struct{}\ntype STAT_FHO struct {\n\tTOTAL *float64\n}\n")...), basePackage: baseSrc})
+	assert.ErrorContains(t, err, "TOTAL")
+}
+
+func TestLineTypeHomes(t *testing.T) {
+	fho := lineTypeCode{dataStruct: "type STAT_FHO struct {\n}\n", headerColumns: []string{"VERSION", "MODEL"}}
+	cnt := lineTypeCode{dataStruct: "type STAT_CNT struct {\n}\n", headerColumns: []string{"VERSION", "MODEL"}}
+	changedCnt := cnt
+	changedCnt.headerColumns = []string{"VERSION", "MODEL", "DESC"}
+	ecnt := lineTypeCode{dataStruct: "type STAT_ECNT struct {\n}\n", headerColumns: []string{"VERSION", "MODEL"}}
+	changedEcnt := ecnt
+	changedEcnt.headerColumns = []string{"VERSION", "MODEL", "DESC"}
+	seeps := lineTypeCode{dataStruct: "type STAT_SEEPS struct {\n}\n"}
+	v10 := &versionCode{parserVersion: "v10_0", lineTypes: map[string]lineTypeCode{"STAT_CNT": cnt, "STAT_ECNT": ecnt, "STAT_FHO": fho}}
+	v11 := &versionCode{parserVersion: "v11_0", lineTypes: map[string]lineTypeCode{"STAT_CNT": changedCnt, "STAT_ECNT": ecnt, "STAT_FHO": fho}}
+	v12 := &versionCode{parserVersion: "v12_0", lineTypes: map[string]lineTypeCode{"STAT_CNT": changedCnt, "STAT_ECNT": changedEcnt, "STAT_FHO": fho, "STAT_SEEPS": seeps}}
+	v13 := &versionCode{parserVersion: "v13_0", lineTypes: map[string]lineTypeCode{"STAT_CNT": changedCnt, "STAT_ECNT": changedEcnt}}
+	homes := lineTypeHomes([]*versionCode{v10, v11, v12, v13})
+	// STAT_FHO is the same in every version, the STAT_CNT of v11.0 is the most common one, the two STAT_ECNT are
+	// equally common and the later one is in the base package, the other one in the package of v10.0
+	assert.Equal(t, map[string]map[string]string{
+		"v10_0": {"STAT_CNT": "v10_0", "STAT_ECNT": "v10_0", "STAT_FHO": basePackage},
+		"v11_0": {"STAT_CNT": basePackage, "STAT_ECNT": "v10_0", "STAT_FHO": basePackage},
+		"v12_0": {"STAT_CNT": basePackage, "STAT_ECNT": basePackage, "STAT_FHO": basePackage, "STAT_SEEPS": "v12_0"},
+		"v13_0": {"STAT_CNT": basePackage, "STAT_ECNT": basePackage},
+	}, homes)
+	assert.Equal(t, []string{"STAT_CNT", "STAT_ECNT", "STAT_FHO"}, baseLineTypes(homes))
+	// a line type that only one version has is in the package of that version
+	assert.Empty(t, baseLineTypes(lineTypeHomes([]*versionCode{v12})))
+}
+
+/*
+writeTestSnapshot writes a snapshot of inputs that has the embedded met_header_columns tables and empty MET
+source and user guide files, so that every column that is not typed by the generator config is a string, and
+makes it the source directory of the generator until the test ends.
+*/
+func writeTestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	for _, version := range util.ColumnDefsVersions() {
+		columnLines, err := util.ColumnDefs(version)
+		assert.NoError(t, err)
+		tablePath, err := sourcePath(util.MetHeaderColumnsFileUrls[version])
+		assert.NoError(t, err)
+		assert.NoError(t, writeSnapshotFile(filepath.Join(dir, tablePath), []byte(strings.Join(columnLines, "\n")+"\n")))
+	}
+	for _, fileUrl := range append(slices.Clone(util.MetSrcFiles), util.MetUserDocFiles...) {
+		path, err := sourcePath(fileUrl)
+		assert.NoError(t, err)
+		assert.NoError(t, writeSnapshotFile(filepath.Join(dir, path), nil))
+	}
+	saved := *sources
+	t.Cleanup(func() { *sources = saved })
+	sources.dir = dir
+}
+
+// TestCheckVersion checks the committed packages against the test snapshot, the line types and columns must be the same, only their types differ.
+func TestCheckVersion(t *testing.T) {
+	writeTestSnapshot(t)
+	packages, err := generateLineTypes(util.ColumnDefsVersions())
+	assert.NoError(t, err)
+	assert.Contains(t, packages, basePackage)
+	diffs, err := checkVersion(packages, "v12_0", "../pkg/linetypes")
+	assert.NoError(t, err)
+	assert.Contains(t, diffs, "STAT_CNT: retyped data column FBAR *float64 -> string")
+	// STAT_FHO is in the base package
+	assert.Contains(t, diffs, "STAT_FHO: retyped data column F_RATE *float64 -> string")
+	// the code differs with the columns
+	assert.Equal(t, "the generated code differs", diffs[len(diffs)-1])
+	for _, diff := range diffs[:len(diffs)-1] {
+		assert.Contains(t, diff, ": retyped ")
+	}
+	_, err = checkVersion(packages, "v12_0", t.TempDir())
+	assert.Error(t, err)
+}
+
+// generateTestTree generates the packages and the column types tables of the test snapshot into a temporary tree.
+func generateTestTree(t *testing.T) (linetypesDir string, columnDefsDir string) {
+	writeTestSnapshot(t)
+	versions := util.ColumnDefsVersions()
+	packages, err := generateLineTypes(versions)
+	assert.NoError(t, err)
+	tables, err := generateColumnTypeTables(packages, versions)
+	assert.NoError(t, err)
+	linetypesDir, columnDefsDir = t.TempDir(), t.TempDir()
+	assert.NoError(t, writeLineTypePackages(linetypesDir, packages))
+	assert.NoError(t, writeColumnTypeTables(columnDefsDir, tables))
+	return linetypesDir, columnDefsDir
+}
+
+// TestCheckLineTypesGenerated checks that a tree that was generated from the inputs that -check reads is up to date.
+func TestCheckLineTypesGenerated(t *testing.T) {
+	linetypesDir, columnDefsDir := generateTestTree(t)
+	versions := util.ColumnDefsVersions()
+	var out strings.Builder
+	assert.True(t, checkLineTypes(&out, versions, versions, linetypesDir, columnDefsDir), out.String())
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		assert.Contains(t, line, ": up to date")
+	}
+	// the exit status of the generator
+	cmd := exec.Command(os.Args[0], "-test.run=^TestGeneratorMain$")
+	cmd.Env = append(os.Environ(), "GENERATOR_TEST_ARGS=-check -source-dir="+sources.dir+" -linetypes-dir="+linetypesDir+" -column-defs-dir="+columnDefsDir)
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+// TestGeneratorMain runs the generator with the arguments of GENERATOR_TEST_ARGS, for the tests of its exit status.
+func TestGeneratorMain(t *testing.T) {
+	args := os.Getenv("GENERATOR_TEST_ARGS")
+	if args == "" {
+		t.Skip("the generator is only run by the tests of its exit status")
+	}
+	os.Args = append([]string{"generator"}, strings.Fields(args)...)
+	main()
+}
+
+// TestCheckLineTypesCodeDiffers checks that a change of the formatting or of the code is reported on its own.
+func TestCheckLineTypesCodeDiffers(t *testing.T) {
+	tests := map[string]func(src string) string{
+		"formatting": func(src string) string { return strings.Replace(src, "\n\n", "\n\n\n", 1) },
+		"code":       func(src string) string { return src + "\nfunc unused() {}\n" },
+	}
+	for name, edit := range tests {
+		t.Run(name, func(t *testing.T) {
+			linetypesDir, columnDefsDir := generateTestTree(t)
+			path := filepath.Join(linetypesDir, "v11_0", "linetypes.go")
+			src, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.NoError(t, os.WriteFile(path, []byte(edit(string(src))), 0o644))
+			versions := util.ColumnDefsVersions()
+			var out strings.Builder
+			assert.False(t, checkLineTypes(&out, versions, versions, linetypesDir, columnDefsDir))
+			assert.Contains(t, out.String(), "v11_0: 1 differences\n    the generated code differs\n")
+			assert.Contains(t, out.String(), "v12_0: up to date\n")
+			assert.Contains(t, out.String(), "base: up to date\n")
+		})
+	}
+}
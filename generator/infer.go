@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The type inference pass scans a directory of MET output files and infers the type of every column from the
+values in the files, i.e.
+
+	go run ./generator -version=v12.0 -infer-dir=/tmp/testdata/tcst
+
+It reports the columns that have no type in the MET source and user guide files (which the generated code
+treats as strings) and the columns whose values do not fit their documented type, and it proposes the
+versionFieldTypes entries of the generator config (see config.go) for them. No code is generated in this mode.
+The data columns of a line are named by the met_header_columns file, only the columns before the first
+repeated sequence of a line type can be named, the header columns are named by the header line of the file.
+*/
+
+// columnObservation counts the kinds of values that were seen in a column.
+type columnObservation struct {
+	ints    int
+	padded  int // ints with a leading zero i.e. the CYCLONE number 02, which are identifiers rather than numbers
+	floats  int
+	strings int
+	example string // the first value that is not a number, or the first value
+}
+
+// corpusTypes are the observations of the columns of a corpus, by column name.
+type corpusTypes struct {
+	columns map[string]*columnObservation
+	lines   int
+}
+
+// observe records a value of a column, missing values are ignored.
+func (o *columnObservation) observe(value string) {
+	if value == "" || value == "NA" {
+		return
+	}
+	if _, err := strconv.Atoi(value); err == nil {
+		o.ints++
+		if len(value) > 1 && value[0] == '0' {
+			o.padded++
+		}
+	} else if _, err := strconv.ParseFloat(value, 64); err == nil {
+		o.floats++
+	} else {
+		if o.strings == 0 {
+			o.example = value
+		}
+		o.strings++
+	}
+	if o.example == "" {
+		o.example = value
+	}
+}
+
+/*
+inferredType returns the narrowest type that fits every observed value, or "" if there were only missing values.
+A column of ints that has zero padded values is a string, so that the padding is kept.
+*/
+func (o *columnObservation) inferredType() string {
+	switch {
+	case o.strings > 0, o.padded > 0 && o.floats == 0:
+		return "string"
+	case o.floats > 0:
+		return "float64"
+	case o.ints > 0:
+		return "int"
+	}
+	return ""
+}
+
+/*
+inferCorpusTypes reads every MET output file under dir and observes the values of its columns.
+columnLines are the lines of the met_header_columns file that name the data columns.
+*/
+func inferCorpusTypes(dir string, parserVersion string, columnLines []string) (*corpusTypes, error) {
+	dataColumns := make(map[string][]string)
+	for _, line := range columnLines {
+		fieldStr, fileType, lineType, err := getFileLineType(line)
+		if err != nil {
+			continue
+		}
+		fileLineType := fileType + "_" + lineType
+		_, dataFields := util.SplitColumnDefLine(fileLineType, fieldStr)
+		dataFields = util.RespellColumns(getConfig().ColumnSpellings, fileLineType, dataFields)
+		for i, field := range dataFields {
+			if strings.HasPrefix(field, "(N_") {
+				// the columns after a repeated sequence do not have a fixed position
+				dataFields = dataFields[:i]
+				break
+			}
+		}
+		dataColumns[fileLineType] = dataFields
+	}
+	corpus := &corpusTypes{columns: make(map[string]*columnObservation)}
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		return corpus.observeFile(path, parserVersion, dataColumns)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the corpus: %w", err)
+	}
+	return corpus, nil
+}
+
+// observeFile observes the values of the data lines of a MET output file, a file without a header line is skipped.
+func (c *corpusTypes) observeFile(path string, parserVersion string, dataColumns map[string][]string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var header *util.CompiledHeader
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "VERSION ") {
+			header = util.CompileHeader(line, path, parserVersion)
+			continue
+		}
+		if header == nil || strings.TrimSpace(line) == "" {
+			continue
+		}
+		// a line without a data key can still be observed, a truncated line has no fileLineType
+		fileLineType, _, _, _, _, _ := header.GetLineType(line)
+		if fileLineType == "" {
+			continue
+		}
+		values := strings.Fields(line)
+		for i, column := range header.HeaderStringFields {
+			if i < len(values) {
+				c.observe(column, values[i])
+			}
+		}
+		for i, column := range dataColumns[fileLineType] {
+			if index := len(header.HeaderStringFields) + i; index < len(values) {
+				c.observe(column, values[index])
+			}
+		}
+		c.lines++
+	}
+	return scanner.Err()
+}
+
+// observe records a value of a column.
+func (c *corpusTypes) observe(column string, value string) {
+	observation, ok := c.columns[column]
+	if !ok {
+		observation = &columnObservation{}
+		c.columns[column] = observation
+	}
+	observation.observe(value)
+}
+
+// typeFinding is a column whose inferred type is not its generated type.
+type typeFinding struct {
+	column     string
+	documented string // the type from the MET inputs and the config, or UNDEFINED
+	inferred   string
+	proposed   string // the proposed config type, "" if there is none
+	observed   *columnObservation
+}
+
+/*
+findTypes compares the inferred types with the types that the generator would use. A column that has no
+documented type and only numeric values gets a proposed type, as does an int column that has float values.
+A numeric column with values that are not numbers is reported without a proposal, the values are more likely
+to be wrong than the documentation. The date and lead columns are converted by the parser and are not compared.
+*/
+func (c *corpusTypes) findTypes(metDataTypesForLines map[string]string, fieldNameMap map[string]string) []typeFinding {
+	var findings []typeFinding
+	for column, observation := range c.columns {
+		inferred := observation.inferredType()
+		if inferred == "" || slices.Contains(util.DateFieldNames, column) || slices.Contains(util.IntFieldNames, column) {
+			continue
+		}
+		_, documented := getDataType(column, &metDataTypesForLines)
+		if documented == "string" && fieldNameMap[column] == "UNDEFINED" && metDataTypesForLines[column] == "" {
+			documented = "UNDEFINED"
+		}
+		finding := typeFinding{column: column, documented: documented, inferred: inferred, observed: observation}
+		switch {
+		case documented == inferred, documented == "float64" && inferred == "int":
+			continue
+		case documented == "UNDEFINED" && inferred != "string":
+			finding.proposed = inferred
+		case documented == "int" && inferred == "float64":
+			finding.proposed = inferred
+		case documented == "UNDEFINED", documented != "int" && documented != "float64" && documented != "string":
+			// an undefined string column is generated as a string, maps are the repeated sequences
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	slices.SortFunc(findings, func(a, b typeFinding) int { return strings.Compare(a.column, b.column) })
+	return findings
+}
+
+// inferenceReport returns the report of the type inference pass, with the proposed versionFieldTypes of the generator config.
+func inferenceReport(dir string, parserVersion string, corpus *corpusTypes, findings []typeFinding) (string, error) {
+	var report strings.Builder
+	fmt.Fprintf(&report, "Inferred column types for %s from %d data lines in %s\n\n", parserVersion, corpus.lines, dir)
+	if len(findings) == 0 {
+		report.WriteString("The observed values fit the generated types of every column.\n")
+		return report.String(), nil
+	}
+	w := tabwriter.NewWriter(&report, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "column\tdocumented\tinferred\tints\tpadded\tfloats\tstrings\texample")
+	proposed := make(map[string]string)
+	for _, finding := range findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n", finding.column, finding.documented, finding.inferred,
+			finding.observed.ints, finding.observed.padded, finding.observed.floats, finding.observed.strings, finding.observed.example)
+		if finding.proposed != "" {
+			proposed[finding.column] = finding.proposed
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	if len(proposed) == 0 {
+		return report.String(), nil
+	}
+	proposal, err := json.MarshalIndent(map[string]map[string]map[string]string{"versionFieldTypes": {parserVersion: proposed}}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	report.WriteString("\nProposed generator config entries:\n")
+	report.Write(proposal)
+	report.WriteString("\n")
+	return report.String(), nil
+}
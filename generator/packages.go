@@ -0,0 +1,433 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+/*
+The generator writes a linetypes package for every parser version and a base package, i.e.
+
+	go run ./generator -linetypes-dir=pkg/linetypes
+
+The versions whose generated code of a line type is the same share one definition of it. The definition that
+most versions have, i.e. the one of STAT_FHO or STAT_PCT, is only generated once, in pkg/linetypes/base. Another
+definition that more than one version has, i.e. the STAT_ECNT of v10.0 and v10.1, is generated in the package of
+the first of these versions, and a definition that only one version has is generated in the package of that
+version. A version package has type aliases for the line types that it shares, so v12_0.STAT_FHO is still a line
+type of v12_0, and its GetDocForId and AddDataElement pass the shared line types on to the package that defines
+them. Every version is generated in one run because the base package depends on all of them.
+*/
+
+// basePackage is the name of the package with the line types that are shared by the versions
+const basePackage = "base"
+
+// linetypesPackagePath is the import path of the directory of the linetypes packages
+const linetypesPackagePath = "github.com/NOAA-GSL/METstat2json/pkg/linetypes/"
+
+// utilityFuncs are the functions that the fill functions use, they are in the base package
+const utilityFuncs = `
+func GetLeadFromInitValid(data []string, dataFieldIndex int) string {
+	initTime, _ := time.Parse("20060102_150405", data[dataFieldIndex-1])
+	validTime, _ := time.Parse("20060102_150405", data[dataFieldIndex+1])
+	lead := validTime.Sub(initTime)
+	return strconv.FormatInt(int64(lead.Hours()), 10)
+}
+
+func SetValueForField(doc *map[string]interface{}, fileType string, term string, i int, dataLen int, fields []string, fieldIndex int, dataType string) {
+	if term == "INIT" && fileType != "TCST" {
+		// do not assign any value to the INIT field for TCST files
+		// The INIT field needs to be moved from the header to the data section.
+		return
+	}
+	if term == "LEAD" && fileType == "TCST" {
+		// This is a special case for TCST files.
+		// if the TERM is LEAD and the lead is NA then we
+		// have to get the lead from the init and valid fields.
+		// INIT is the prior field and VALID is the next field from LEAD.
+		// the init and valid fields are in the format YYYYMMDD_HHMMSS
+		// the lead is the difference between the valid and the init
+		// in hours
+		(*doc)["LEAD"], _ = strconv.Atoi(GetLeadFromInitValid(fields, fieldIndex))
+		return
+	}
+	if i <= dataLen && fields[fieldIndex] != "" && fields[fieldIndex] != "NA" {
+		switch dataType {
+		case "int":
+			(*doc)[term], _ = strconv.Atoi(fields[fieldIndex])
+			return
+		case "float64":
+			(*doc)[term], _ = strconv.ParseFloat(fields[fieldIndex], 64)
+			return
+		case "string":
+			(*doc)[term] = fields[fieldIndex]
+			return
+		default:
+			(*doc)[term] = fields[fieldIndex]
+			return
+		}
+	}
+	// a missing value is nil, the parser decides if it is left out, written as null or as a sentinel value
+	(*doc)[term] = nil
+}
+
+// ParseIntField returns a pointer to the value of an int data field, or nil if the field is missing i.e. "NA",
+// so that a real 0 is kept in the JSON output
+func ParseIntField(field string) *int {
+	value, err := strconv.Atoi(field)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// ParseFloatField returns a pointer to the value of a float64 data field, or nil if the field is missing i.e. "NA",
+// so that a real 0 is kept in the JSON output
+func ParseFloatField(field string) *float64 {
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}`
+
+// utilityFuncAliases make the utility funcs of the base package available in a version package
+const utilityFuncAliases = `
+// the utility functions of the fill functions are in the base package
+var (
+	GetLeadFromInitValid = base.GetLeadFromInitValid
+	SetValueForField     = base.SetValueForField
+	ParseIntField        = base.ParseIntField
+	ParseFloatField      = base.ParseFloatField
+)`
+
+// equal reports whether two versions generate the same code for a line type.
+func (c lineTypeCode) equal(other lineTypeCode) bool {
+	return c.headerStruct == other.headerStruct && c.fillHeader == other.fillHeader &&
+		c.dataStruct == other.dataStruct && c.fillData == other.fillData &&
+		slices.Equal(c.headerColumns, other.headerColumns)
+}
+
+/*
+lineTypeHomes returns the package that defines each line type of each version, by parser version and fileLineType.
+The versions whose code of a line type is the same share one definition. The definition that most versions have
+is in the base package, of equally common definitions the one of the later versions, as long as more than one
+version has it. Another definition that more than one version has is in the package of the first of these
+versions, and a definition that only one version has is in the package of that version.
+*/
+func lineTypeHomes(versions []*versionCode) map[string]map[string]string {
+	// the versions of every fileLineType, grouped by their code, in the order of the versions
+	groups := make(map[string][][]*versionCode)
+	for _, version := range versions {
+		for fileLineType, lineType := range version.lineTypes {
+			i := slices.IndexFunc(groups[fileLineType], func(group []*versionCode) bool {
+				return group[0].lineTypes[fileLineType].equal(lineType)
+			})
+			if i < 0 {
+				groups[fileLineType] = append(groups[fileLineType], []*versionCode{version})
+			} else {
+				groups[fileLineType][i] = append(groups[fileLineType][i], version)
+			}
+		}
+	}
+	homes := make(map[string]map[string]string, len(versions))
+	for _, version := range versions {
+		homes[version.parserVersion] = make(map[string]string, len(version.lineTypes))
+	}
+	for fileLineType, fileLineTypeGroups := range groups {
+		baseGroup := -1
+		for i, group := range fileLineTypeGroups {
+			if len(group) > 1 && (baseGroup < 0 || len(group) >= len(fileLineTypeGroups[baseGroup])) {
+				baseGroup = i
+			}
+		}
+		for i, group := range fileLineTypeGroups {
+			home := group[0].parserVersion
+			if i == baseGroup {
+				home = basePackage
+			}
+			for _, version := range group {
+				homes[version.parserVersion][fileLineType] = home
+			}
+		}
+	}
+	return homes
+}
+
+// baseLineTypes returns the fileLineTypes that are defined in the base package, sorted.
+func baseLineTypes(homes map[string]map[string]string) []string {
+	var shared []string
+	for _, versionHomes := range homes {
+		for fileLineType, home := range versionHomes {
+			if home == basePackage && !slices.Contains(shared, fileLineType) {
+				shared = append(shared, fileLineType)
+			}
+		}
+	}
+	slices.Sort(shared)
+	return shared
+}
+
+/*
+generateLineTypes generates the base package and the package of every parser version, gofmt formatted,
+by package name i.e. base or v12_0.
+*/
+func generateLineTypes(parserVersions []string) (map[string][]byte, error) {
+	versions := make([]*versionCode, 0, len(parserVersions))
+	for _, parserVersion := range parserVersions {
+		// every version lists its own inputs
+		sources.inputs = nil
+		version, err := buildVersionCode(parserVersion)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", parserVersion, err)
+		}
+		versions = append(versions, version)
+	}
+	homes := lineTypeHomes(versions)
+	packages := make(map[string][]byte)
+	var buf bytes.Buffer
+	writeBasePackage(&buf, versions, homes)
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("the generated code of %s does not compile: %w", basePackage, err)
+	}
+	packages[basePackage] = formatted
+	for _, version := range versions {
+		buf.Reset()
+		writeVersionPackage(&buf, version, homes[version.parserVersion])
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("the generated code of %s does not compile: %w", version.parserVersion, err)
+		}
+		packages[version.parserVersion] = formatted
+	}
+	return packages, nil
+}
+
+// writeLineTypePackages writes the generated packages to their linetypes.go files in the linetypes directory.
+func writeLineTypePackages(linetypesDir string, packages map[string][]byte) error {
+	for _, name := range getSortedKeys(packages) {
+		dir := filepath.Join(linetypesDir, name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("error creating the %s package: %w", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "linetypes.go"), packages[name], 0o644); err != nil {
+			return fmt.Errorf("error writing the %s package: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// writeBasePackage writes the base package, with the line types of the versions whose home is the base package, see lineTypeHomes.
+func writeBasePackage(w io.Writer, versions []*versionCode, homes map[string]map[string]string) {
+	shared := baseLineTypes(homes)
+	lineTypes := make(map[string]lineTypeCode)
+	for _, version := range versions {
+		for fileLineType, home := range homes[version.parserVersion] {
+			if home == basePackage {
+				lineTypes[fileLineType] = version.lineTypes[fileLineType]
+			}
+		}
+	}
+	var body bytes.Buffer
+	fmt.Fprintln(&body, utilityFuncs)
+	writeLineTypeCode(&body, lineTypes, shared,
+		"\tdefault:\n\t\treturn nil, errors.New(\"GetDocForId: Unknown file_line type:\" + fileLineType)\n",
+		"\tdefault:\n\t\treturn nil, errors.New(\"AddDataElement: Unknown file_line type:\" + fileLineType)\n")
+
+	writePackageHeader(w, basePackage, body.Bytes(),
+		"This package has the line types whose code is the same in most of the MET versions that have them,\n"+
+			"the version packages i.e. v12_0 have type aliases for them and the line types that differ.\n")
+	w.Write(body.Bytes())
+}
+
+/*
+writeVersionPackage writes the package of a parser version, with the line types whose home is the package of the
+version and type aliases for the others, see lineTypeHomes. The homes are the ones of this version.
+*/
+func writeVersionPackage(w io.Writer, version *versionCode, homes map[string]string) {
+	lineTypes := make(map[string]lineTypeCode)
+	var caseOrder, aliases []string
+	// the line types that are defined in the package of an earlier version, by package
+	delegated := make(map[string][]string)
+	for _, fileLineType := range version.fileLineTypes {
+		switch home := homes[fileLineType]; home {
+		case version.parserVersion:
+			lineTypes[fileLineType] = version.lineTypes[fileLineType]
+			caseOrder = append(caseOrder, fileLineType)
+		case basePackage:
+			aliases = append(aliases, fileLineType)
+		default:
+			aliases = append(aliases, fileLineType)
+			delegated[home] = append(delegated[home], fileLineType)
+		}
+	}
+	slices.Sort(aliases)
+	// the cases of the delegated line types go before the default case, which passes the base line types on
+	var docIDDelegates, addDataElementDelegates string
+	for _, home := range getSortedKeys(delegated) {
+		cases := "\tcase \"" + strings.Join(delegated[home], "\", \"") + "\":\n"
+		docIDDelegates += cases + "\t\treturn " + home + ".GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)\n"
+		addDataElementDelegates += cases + "\t\treturn " + home + ".AddDataElement(dataKey, fileLineType, dataData, doc)\n"
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintln(&body, utilityFuncAliases)
+	fmt.Fprintln(&body, "")
+	fmt.Fprintln(&body, "// the line types that are the same in other versions are defined in the base package or in the package of an earlier version\ntype (")
+	for _, fileLineType := range aliases {
+		home := homes[fileLineType]
+		fmt.Fprintf(&body, "\t%s = %s.%s\n\t%s_header = %s.%s_header\n", fileLineType, home, fileLineType, fileLineType, home, fileLineType)
+	}
+	fmt.Fprintln(&body, ")")
+	writeLineTypeCode(&body, lineTypes, caseOrder,
+		docIDDelegates+"\tdefault:\n\t\tif slices.Contains(lineTypes, fileLineType) {\n"+
+			"\t\t\treturn base.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)\n\t\t}\n"+
+			"\t\treturn nil, errors.New(\"GetDocForId: Unknown file_line type:\" + fileLineType)\n",
+		addDataElementDelegates+"\tdefault:\n\t\tif slices.Contains(lineTypes, fileLineType) {\n"+
+			"\t\t\treturn base.AddDataElement(dataKey, fileLineType, dataData, doc)\n\t\t}\n"+
+			"\t\treturn nil, errors.New(\"AddDataElement: Unknown file_line type:\" + fileLineType)\n")
+
+	// print the DateFieldNames
+	fmt.Fprintln(&body, "")
+	fmt.Fprintln(&body, "var MetHeaderColumnsFileUrl = \""+version.columnDefsUrl+"\"")
+	fmt.Fprintln(&body, "")
+
+	// print the LineTypeSet that registers this version with the parser
+	headerColumns := make(map[string][]string, len(version.lineTypes))
+	for fileLineType, lineType := range version.lineTypes {
+		headerColumns[fileLineType] = lineType.headerColumns
+	}
+	fmt.Fprintln(&body, getLineTypeSetString(version.parserVersion, getSortedKeys(version.lineTypes), headerColumns))
+
+	fmt.Fprint(w, version.undefinedComment)
+	writePackageHeader(w, version.parserVersion, body.Bytes(),
+		fmt.Sprintf("This package has the line types of MET %s that differ from the other versions and type aliases\n"+
+			"for the line types in the base package and in the packages of earlier versions.\n\n%s", strings.ReplaceAll(version.parserVersion, "_", "."), version.hashComment))
+	w.Write(body.Bytes())
+}
+
+// importedPackages are the packages that the generated code can use, by name.
+var importedPackages = map[string]string{
+	"errors":  "errors",
+	"fmt":     "fmt",
+	"slices":  "slices",
+	"strconv": "strconv",
+	"time":    "time",
+	"util":    "github.com/NOAA-GSL/METstat2json/pkg/util",
+}
+
+// packageUsePattern matches the use of a package in the generated code, i.e. strconv.Atoi or v10_0.GetDocForId
+var packageUsePattern = regexp.MustCompile(`\b(errors|fmt|slices|strconv|time|util|base|v[0-9]+_[0-9]+)\.[A-Z]`)
+
+// writePackageHeader writes the package clause, the imports that the body uses and the generated code comment.
+func writePackageHeader(w io.Writer, name string, body []byte, description string) {
+	used := make(map[string]bool)
+	for _, match := range packageUsePattern.FindAllSubmatch(body, -1) {
+		used[string(match[1])] = true
+	}
+	var std, module []string
+	for _, pkg := range getSortedKeys(used) {
+		path, ok := importedPackages[pkg]
+		if !ok {
+			// the base package and the version packages
+			path = linetypesPackagePath + pkg
+		}
+		if strings.Contains(path, "/") {
+			module = append(module, fmt.Sprintf("\t%q\n", path))
+		} else {
+			std = append(std, fmt.Sprintf("\t%q\n", path))
+		}
+	}
+	fmt.Fprintln(w, "package "+name)
+	fmt.Fprintln(w, "")
+	fmt.Fprint(w, "import (\n"+strings.Join(std, ""))
+	if len(std) > 0 && len(module) > 0 {
+		fmt.Fprintln(w, "")
+	}
+	fmt.Fprintln(w, strings.Join(module, "")+")")
+	fmt.Fprintln(w, "\n/*\nTHIS CODE IS AUTOMATICALLY GENERATED - DO NOT EDIT THIS CODE")
+	fmt.Fprintln(w, "To modify this code - modify the generator.go file and run the generator.go program")
+	fmt.Fprintln(w, "cd  <repo_root>")
+	fmt.Fprintln(w, "go run ./generator -linetypes-dir=pkg/linetypes")
+	fmt.Fprintln(w, "")
+	fmt.Fprint(w, description)
+	fmt.Fprintln(w, "*/")
+}
+
+/*
+writeLineTypeCode writes the structs and fill functions of the line types and the GetDocForId and AddDataElement
+functions, with the cases of the line types in caseOrder and the given default cases.
+*/
+func writeLineTypeCode(w io.Writer, lineTypes map[string]lineTypeCode, caseOrder []string, docIDDefault string, addDataElementDefault string) {
+	// create the getDocFoID function
+	docIDString := "func GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {\n"
+	if len(caseOrder) > 0 {
+		docIDString += "\tdoc := make(map[string] interface{})\n\t// add the metadata to the doc\n\tfor key, value := range metaDataMap {\n\t\tdoc[key] = value\n\t}\n"
+	}
+	docIDString += "\tswitch fileLineType {\n"
+	addDataElementString := "func AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {\n\tswitch fileLineType {\n"
+	for _, fileLineType := range caseOrder {
+		docIDString += lineTypes[fileLineType].getDocIDCase
+		addDataElementString += lineTypes[fileLineType].addDataElementCase
+	}
+	// end the switch statements in the getDocForId and addDataElementStrings, a package without line types of its
+	// own only has the default cases, which return
+	docIDString += docIDDefault + "\t}\n"
+	addDataElementString += addDataElementDefault + "\t}\n"
+	if len(caseOrder) > 0 {
+		docIDString += "\treturn doc, nil\n"
+		addDataElementString += "\treturn *doc, nil\n"
+	}
+	docIDString += "}\n"
+	addDataElementString += "}\n"
+
+	// the header structs and fillHeader functions are sorted by the header struct name, the others by the line type
+	headerKeys := make(map[string]string, len(lineTypes))
+	for fileLineType := range lineTypes {
+		headerKeys[fileLineType+"_header"] = fileLineType
+	}
+	// print the header structs in order
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//Header struct definitions")
+	for _, key := range getSortedKeys(headerKeys) {
+		fmt.Fprintln(w, lineTypes[headerKeys[key]].headerStruct)
+	}
+	// print the fillHeader functions in order
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//fillHeader functions")
+	for _, key := range getSortedKeys(headerKeys) {
+		fmt.Fprintln(w, lineTypes[headerKeys[key]].fillHeader)
+	}
+
+	// print the data structs in order
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//line data struct definitions")
+	for _, key := range getSortedKeys(lineTypes) {
+		fmt.Fprintln(w, lineTypes[key].dataStruct)
+	}
+
+	// print the fillStructure functions in order
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//fillStructure functions")
+	for _, key := range getSortedKeys(lineTypes) {
+		fmt.Fprintln(w, lineTypes[key].fillData)
+	}
+
+	// print the getDocForId functions
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//getDocForId functions")
+	fmt.Fprintln(w, docIDString)
+
+	// print the addDataElement functions
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "//addDataElement functions")
+	fmt.Fprintln(w, addDataElementString)
+}
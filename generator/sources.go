@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+The generator reads the met_header_columns file, the MET source files and the MET user guide files from the
+raw.githubusercontent.com URLs in the util package. With -source-dir the same files are read from a local MET
+checkout, or from a snapshot directory that has the same layout, i.e.
+
+	go run ./generator -snapshot-dir=/tmp/met_v12.0
+	go run ./generator -source-dir=/tmp/met_v12.0
+
+The first command downloads the inputs and saves them in /tmp/met_v12.0, the second one generates the same code
+without the network. The generator writes the sha256 hash of every input of a version in the header comment of
+its generated package. The packages that are checked in were not generated from a pinned snapshot and have no
+hashes yet, -check reports them until they are regenerated.
+*/
+
+// sourceInput is an input of the generator, by its path in the MET repository, and the hash of its content.
+type sourceInput struct {
+	path string
+	hash string
+}
+
+// sourceSet reads the inputs of the generator and records their hashes.
+type sourceSet struct {
+	dir         string // a MET checkout or a snapshot directory, "" means that the inputs are downloaded
+	snapshotDir string // the downloaded inputs are saved here if it is not ""
+	inputs      []sourceInput
+}
+
+// sources are the inputs of this run of the generator, see main.
+var sources = &sourceSet{}
+
+/*
+sourcePath returns the path of a MET file in the MET repository from its raw.githubusercontent.com URL, i.e.
+docs/Users_Guide/mode.rst for https://raw.githubusercontent.com/dtcenter/MET/refs/heads/main_v12.0/docs/Users_Guide/mode.rst
+*/
+func sourcePath(fileUrl string) (string, error) {
+	_, after, found := strings.Cut(fileUrl, "/refs/heads/")
+	if !found {
+		return "", fmt.Errorf("not a MET repository URL: %s", fileUrl)
+	}
+	// remove the branch
+	_, path, found := strings.Cut(after, "/")
+	if !found || path == "" {
+		return "", fmt.Errorf("not a MET repository URL: %s", fileUrl)
+	}
+	return path, nil
+}
+
+// read returns the content of the input for a URL, from the source directory or downloaded, and records its hash.
+func (s *sourceSet) read(fileUrl string) ([]byte, error) {
+	path, err := sourcePath(fileUrl)
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	if s.dir != "" {
+		content, err = os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(path)))
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s from the source directory: %w", path, err)
+		}
+	} else {
+		content, err = download(fileUrl)
+		if err != nil {
+			return nil, err
+		}
+		if s.snapshotDir != "" {
+			if err := writeSnapshotFile(filepath.Join(s.snapshotDir, filepath.FromSlash(path)), content); err != nil {
+				return nil, err
+			}
+		}
+	}
+	s.record(path, content)
+	return content, nil
+}
+
+// record adds the hash of an input, an input that is read more than once is only recorded the first time.
+func (s *sourceSet) record(path string, content []byte) {
+	for _, input := range s.inputs {
+		if input.path == path {
+			return
+		}
+	}
+	sum := sha256.Sum256(content)
+	s.inputs = append(s.inputs, sourceInput{path: path, hash: hex.EncodeToString(sum[:])})
+}
+
+// hashComment returns the lines of the generated file header that list the inputs and their hashes, in the order they were read.
+func (s *sourceSet) hashComment() string {
+	comment := "Inputs (sha256):\n"
+	for _, input := range s.inputs {
+		comment += fmt.Sprintf("%s %s\n", input.hash, input.path)
+	}
+	return comment
+}
+
+// download returns the content of a URL.
+func download(fileUrl string) ([]byte, error) {
+	resp, err := http.Get(fileUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s: %w", fileUrl, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting %s: %s", fileUrl, resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", fileUrl, err)
+	}
+	return content, nil
+}
+
+// writeSnapshotFile writes a downloaded input to the snapshot directory.
+func writeSnapshotFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating the snapshot directory: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("error writing the snapshot file: %w", err)
+	}
+	return nil
+}
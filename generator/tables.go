@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The generator writes a column types table for every version next to the met_header_columns files of the util
+package, i.e. pkg/util/column_defs/column_types_V12.0.json. The table has the type of every header and data column
+of the generated line types and the repeating groups and column spellings of the generator config, which is what
+the engine package needs to parse the line types without the generated code. The types are read back from the
+generated packages, so the tables always match the structs of the linetypes packages.
+*/
+
+// columnTypeTable returns the column types table of the generated package of a parser version, the generated packages are by name.
+func columnTypeTable(parserVersion string, packages map[string][]byte) (*util.ColumnTypeTable, error) {
+	lineTypes, err := readLineTypeColumns(packages[parserVersion], packages)
+	if err != nil {
+		return nil, err
+	}
+	table := &util.ColumnTypeTable{
+		Version:         parserVersion,
+		ColumnTypes:     make(map[string]string),
+		RepeatingGroups: getConfig().RepeatingGroups,
+		ColumnSpellings: getConfig().ColumnSpellings,
+	}
+	for _, fileLineType := range getSortedKeys(lineTypes) {
+		for _, columns := range []map[string]string{lineTypes[fileLineType].header, lineTypes[fileLineType].data} {
+			for column, fieldType := range columns {
+				// the repeated sequences are maps, their element types are in the repeating groups
+				fieldType = strings.TrimPrefix(fieldType, "*")
+				if strings.HasPrefix(fieldType, "map[") {
+					continue
+				}
+				if existing, ok := table.ColumnTypes[column]; ok && existing != fieldType {
+					return nil, fmt.Errorf("%s: the column %s is a %s and a %s", parserVersion, column, existing, fieldType)
+				}
+				table.ColumnTypes[column] = fieldType
+			}
+		}
+	}
+	return table, nil
+}
+
+// generateColumnTypeTables returns the JSON column types tables of the generated packages of the parser versions, by version.
+func generateColumnTypeTables(packages map[string][]byte, parserVersions []string) (map[string][]byte, error) {
+	tables := make(map[string][]byte, len(parserVersions))
+	for _, parserVersion := range parserVersions {
+		table, err := columnTypeTable(parserVersion, packages)
+		if err != nil {
+			return nil, err
+		}
+		content, err := json.MarshalIndent(table, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		tables[parserVersion] = append(content, '\n')
+	}
+	return tables, nil
+}
+
+// writeColumnTypeTables writes the column types tables to the column definitions directory of the util package.
+func writeColumnTypeTables(columnDefsDir string, tables map[string][]byte) error {
+	for _, parserVersion := range getSortedKeys(tables) {
+		path := filepath.Join(columnDefsDir, util.ColumnTypesFileName(parserVersion))
+		if err := os.WriteFile(path, tables[parserVersion], 0o644); err != nil {
+			return fmt.Errorf("error writing the column types table: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkColumnTypeTable returns a difference if the committed column types table is not the generated one.
+func checkColumnTypeTable(generated []byte, committedPath string) []string {
+	committed, err := os.ReadFile(committedPath)
+	if err != nil {
+		return []string{fmt.Sprintf("the column types table %s cannot be read", filepath.Base(committedPath))}
+	}
+	if !bytes.Equal(committed, generated) {
+		return []string{fmt.Sprintf("the column types table %s differs", filepath.Base(committedPath))}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The custom line types are the line types of the lines that custom tools and python embedding jobs write, which
+are not in the MET column definitions. They are registered at runtime with util.RegisterCustomLineType, which adds
+their layouts with addLayout, and are parsed by the LineTypeSet that Custom returns, whatever the MET version of a
+line is. The parser uses it for every line whose fileLineType is a registered custom line type.
+*/
+
+// CustomVersion is the version of the LineTypeSet of the custom line types.
+const CustomVersion = "custom"
+
+var customSet = &LineTypeSet{
+	version:       CustomVersion,
+	headerColumns: make(map[string][]string),
+	layouts:       make(map[string]*layout),
+	custom:        true,
+}
+
+func init() {
+	util.RegisterCustomLayouts(addLayout)
+}
+
+// Custom returns the LineTypeSet of the custom line types that are registered with util.RegisterCustomLineType.
+func Custom() *LineTypeSet {
+	return customSet
+}
+
+/*
+addLayout builds the layout of a custom line type that util.RegisterCustomLineType validated and adds it to the
+custom line types. It returns an util.ErrInvalidLineType error if the layout cannot be built.
+*/
+func addLayout(c util.CustomLineType) error {
+	fileLineType := c.FileLineType()
+	headerFields, dataFields := c.Split()
+	lineLayout, err := newLayout(c.FileType, fileLineType, headerFields, dataFields, customColumnTypes(c), c.DataKey)
+	if err != nil {
+		return fmt.Errorf("%w %s: %w", util.ErrInvalidLineType, fileLineType, err)
+	}
+	customSet.mu.Lock()
+	defer customSet.mu.Unlock()
+	customSet.headerColumns[fileLineType] = headerFields
+	customSet.layouts[fileLineType] = lineLayout
+	customSet.lineTypes = append(customSet.lineTypes, fileLineType)
+	slices.Sort(customSet.lineTypes)
+	return nil
+}
+
+// customColumnTypes returns the column types table of a custom line type, the int and date header fields are ints like in the MET line types.
+func customColumnTypes(c util.CustomLineType) *util.ColumnTypeTable {
+	table := &util.ColumnTypeTable{
+		Version:         CustomVersion,
+		ColumnTypes:     make(map[string]string),
+		RepeatingGroups: c.RepeatingGroups,
+	}
+	for _, column := range slices.Concat(util.IntFieldNames, util.DateFieldNames) {
+		table.ColumnTypes[column] = "int"
+	}
+	for column, columnType := range c.ColumnTypes {
+		table.ColumnTypes[fieldName(column)] = columnType
+	}
+	return table
+}
@@ -0,0 +1,415 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/base"
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The engine package parses the line types of a MET version without generated code. It builds the layout of every
+line type at runtime from the embedded met_header_columns table and the column types table of the util package:
+the header and data columns, their types, the repeated sequences and the column spellings. Every line is parsed by the same generic code,
+which makes the engine a drop in util.LineTypeSet that can be benchmarked against the generated linetypes packages,
+see parser.WithTableDrivenLineTypes.
+
+The documents are the same as the ones of the generated code, the data section is a map of a struct type that has
+the same fields and JSON names as the generated data struct, with one difference: the generated code reads the
+repeated sequences, i.e. THRESH_n OY_n ON_n of a STAT_PCT line, at fixed positions, while the engine reads as many
+sequences as the (N_*) column counts, keys them by their number i.e. THRESH_1 OY_1 ON_1 THRESH_2, and reads the
+columns that follow the sequences at their real positions.
+*/
+
+// the Go types of the fields of the data structs, the numbers are pointers so that a missing value is nil
+var (
+	intPointerType   = reflect.TypeOf((*int)(nil))
+	floatPointerType = reflect.TypeOf((*float64)(nil))
+	stringType       = reflect.TypeOf("")
+	sequenceType     = reflect.TypeOf(map[string]interface{}{})
+	missingType      = reflect.TypeOf([]string{})
+)
+
+// headerColumn is a header column of a line type that is written to the document.
+type headerColumn struct {
+	name     string // the name of the column in the document i.e. FCST_LEAD
+	index    int    // the position of the column in the header data
+	dataType string // "int", "float64" or "string"
+}
+
+// dataColumn is a data column or a repeated sequence of a line type, it is a field of the data struct.
+type dataColumn struct {
+	name     string
+	dataType string // "int", "float64", "string" or "" for a repeated sequence
+	group    util.RepeatingGroup
+	sequence util.GroupSequence
+}
+
+// layout is the layout of the columns of a line type.
+type layout struct {
+	fileType   string
+	header     []headerColumn
+	lineType   bool // MODE and MTD lines do not have a LINE_TYPE column, the document gets the fileLineType
+	data       []dataColumn
+	structType reflect.Type // the data struct, the data fields in order and the MISSING field
+	mapType    reflect.Type // the data section of a document, a map of the data struct by dataKey
+	// the columns and the column types that the layout is built from, see LineTypeSet.Grouped
+	headerFields []string
+	dataFields   []string
+	table        *util.ColumnTypeTable
+}
+
+// A LineTypeSet is the util.LineTypeSet of the engine for one MET version, or for the custom line types.
+type LineTypeSet struct {
+	version       string
+	columnDefsUrl string
+	lineTypes     []string
+	headerColumns map[string][]string
+	layouts       map[string]*layout
+	// the custom line types are added after the set is built, the layouts of a MET version never change
+	custom bool
+	mu     sync.RWMutex
+}
+
+var (
+	lineTypeSetsMu sync.Mutex
+	lineTypeSets   = make(map[string]*LineTypeSet)
+)
+
+/*
+Get returns the LineTypeSet of a parser version i.e. v12_0, the layouts of a version are only built once.
+It returns an ErrUnsupportedVersion error if the util package has no column definitions or column types for the version.
+*/
+func Get(version string) (*LineTypeSet, error) {
+	lineTypeSetsMu.Lock()
+	defer lineTypeSetsMu.Unlock()
+	if set, ok := lineTypeSets[version]; ok {
+		return set, nil
+	}
+	set, err := New(version)
+	if err != nil {
+		return nil, err
+	}
+	lineTypeSets[version] = set
+	return set, nil
+}
+
+// New builds the layouts of the line types of a parser version i.e. v12_0 from the embedded tables of the util package.
+func New(version string) (*LineTypeSet, error) {
+	lines, err := util.ColumnDefs(version)
+	if err != nil {
+		return nil, err
+	}
+	table, err := util.ColumnTypes(version)
+	if err != nil {
+		return nil, err
+	}
+	set := &LineTypeSet{
+		version:       version,
+		columnDefsUrl: util.MetHeaderColumnsFileUrls[version],
+		headerColumns: make(map[string][]string),
+		layouts:       make(map[string]*layout),
+	}
+	for _, line := range lines {
+		fileType, lineType, columns, ok := util.SplitColumnDefsLine(line)
+		if !ok {
+			continue
+		}
+		fileLineType := fileType + "_" + lineType
+		headerFields, dataFields := util.SplitColumnDefLine(fileLineType, columns)
+		dataFields = util.RespellColumns(table.ColumnSpellings, fileLineType, dataFields)
+		dataKeyEntry, _ := util.GetDataKeyEntry(fileLineType)
+		lineLayout, err := newLayout(fileType, fileLineType, headerFields, dataFields, table, dataKeyEntry)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", version, fileLineType, err)
+		}
+		// a line type that is defined twice has the columns of its last definition, like the generated code
+		set.headerColumns[fileLineType] = headerFields
+		set.layouts[fileLineType] = lineLayout
+	}
+	for fileLineType := range set.layouts {
+		set.lineTypes = append(set.lineTypes, fileLineType)
+	}
+	slices.Sort(set.lineTypes)
+	return set, nil
+}
+
+// fieldNamePattern matches the characters that are not in the name of a field, i.e. the brackets of [A-Z]F[0-9]*_[A-Z]O[0-9]*.
+var fieldNamePattern = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// fieldName returns the name of the field of a column, i.e. THRESH_I for THRESH_[0-9]*, like the generator names the struct fields.
+func fieldName(column string) string {
+	name := strings.ToUpper(strings.ReplaceAll(column, "[0-9]*", "I"))
+	return fieldNamePattern.ReplaceAllString(name, "")
+}
+
+// newLayout builds the layout of a line type from its header and data columns in the met_header_columns file.
+func newLayout(fileType string, fileLineType string, headerFields []string, dataFields []string, table *util.ColumnTypeTable, dataKeyMap util.DataKeyEntry) (*layout, error) {
+	l := &layout{fileType: fileType, headerFields: headerFields, dataFields: dataFields, table: table}
+	if fileType == "MODE" || fileType == "MTD" {
+		l.lineType = true
+	}
+	for index, column := range headerFields {
+		// the data key columns are in the data section and the disallowed columns are moved there
+		if slices.Contains(dataKeyMap.DataKey, column) || slices.Contains(dataKeyMap.HeaderDisallow, column) {
+			continue
+		}
+		name := fieldName(column)
+		l.header = append(l.header, headerColumn{name: name, index: index, dataType: table.ColumnType(name)})
+	}
+	// the disallowed header columns are appended to the data of a line by the parser
+	dataFields = append(slices.Clone(dataFields), dataKeyMap.HeaderDisallow...)
+	var fields []reflect.StructField
+	for index := 0; index < len(dataFields); index++ {
+		column := dataFields[index]
+		var field dataColumn
+		var fieldType reflect.Type
+		if group, sequence, ok := util.FindRepeatingGroup(table.RepeatingGroups, column, fileLineType); ok {
+			field = dataColumn{name: group.StructField, group: group, sequence: sequence}
+			fieldType = sequenceType
+			// the columns of the sequence follow the (N_*) column, a matrix is one column
+			if group.Layout == "matrix" {
+				index++
+			} else {
+				index += len(sequence.KeyPrefixes)
+			}
+		} else {
+			field = dataColumn{name: fieldName(column), dataType: table.ColumnType(fieldName(column))}
+			switch field.dataType {
+			case "int":
+				fieldType = intPointerType
+			case "float64":
+				fieldType = floatPointerType
+			default:
+				field.dataType = "string"
+				fieldType = stringType
+			}
+		}
+		if !isFieldName(field.name) {
+			return nil, fmt.Errorf("the column %s cannot be a field", column)
+		}
+		l.data = append(l.data, field)
+		fields = append(fields, reflect.StructField{
+			Name: field.name,
+			Type: fieldType,
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:"%s,omitempty"`, util.ToCamelCase(field.name))),
+		})
+	}
+	// the parser records the JSON names of the missing (nil) fields of each data entry
+	fields = append(fields, reflect.StructField{Name: "MISSING", Type: missingType, Tag: `json:"missing,omitempty"`})
+	if err := checkFieldNames(fields); err != nil {
+		return nil, err
+	}
+	l.structType = reflect.StructOf(fields)
+	l.mapType = reflect.MapOf(stringType, l.structType)
+	return l, nil
+}
+
+// isFieldName returns true if a name can be the name of an exported struct field.
+func isFieldName(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z'
+}
+
+// checkFieldNames returns an error if two fields have the same name, reflect.StructOf would panic.
+func checkFieldNames(fields []reflect.StructField) error {
+	names := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if names[field.Name] {
+			return fmt.Errorf("the field %s is defined twice", field.Name)
+		}
+		names[field.Name] = true
+	}
+	return nil
+}
+
+// fillHeader adds the header columns of a line to a document, like the fillHeader functions of the generated code.
+func (l *layout) fillHeader(fileLineType string, headerData []string, doc *map[string]interface{}) {
+	i := -1
+	for _, column := range l.header {
+		i++
+		base.SetValueForField(doc, l.fileType, column.name, i, len(headerData), headerData, column.index, column.dataType)
+	}
+	if l.lineType {
+		(*doc)["LINE_TYPE"] = fileLineType
+	}
+}
+
+// newData returns the data struct of a line, the columns are read in order and a sequence takes as many columns as it counts.
+func (l *layout) newData(fields []string) reflect.Value {
+	data := reflect.New(l.structType).Elem()
+	position := 0
+	for i, column := range l.data {
+		if position >= len(fields) {
+			break
+		}
+		field := data.Field(i)
+		switch column.dataType {
+		case "int":
+			field.Set(reflect.ValueOf(base.ParseIntField(fields[position])))
+		case "float64":
+			field.Set(reflect.ValueOf(base.ParseFloatField(fields[position])))
+		case "string":
+			if fields[position] != "NA" {
+				field.SetString(fields[position])
+			}
+		default:
+			var sequence map[string]interface{}
+			sequence, position = column.readSequence(fields, position)
+			field.Set(reflect.ValueOf(sequence))
+			continue
+		}
+		position++
+	}
+	return data
+}
+
+/*
+readSequence returns the values of a repeated sequence that starts with its (N_*) column at position, and the position
+of the column after the sequence. The values are keyed by their key prefix and number i.e. THRESH_1, or by the
+row and column of a matrix i.e. F1_O2. A value that is missing or not a number for a number type is nil.
+*/
+func (c dataColumn) readSequence(fields []string, position int) (map[string]interface{}, int) {
+	count, err := strconv.Atoi(fields[position])
+	if err != nil || count < 0 {
+		count = 0
+	}
+	position++
+	sequence := make(map[string]interface{})
+	if c.group.Layout == "matrix" {
+		rowPrefix, columnPrefix := c.sequence.KeyPrefixes[0], c.sequence.KeyPrefixes[1]
+		for row := 1; row <= count; row++ {
+			for column := 1; column <= count; column++ {
+				sequence[rowPrefix+strconv.Itoa(row)+"_"+columnPrefix+strconv.Itoa(column)] = sequenceValue(fields, position, c.sequence.ElementType)
+				position++
+			}
+		}
+		return sequence, position
+	}
+	for n := 1; n <= count; n++ {
+		for _, prefix := range c.sequence.KeyPrefixes {
+			sequence[prefix+strconv.Itoa(n)] = sequenceValue(fields, position, c.sequence.ElementType)
+			position++
+		}
+	}
+	return sequence, position
+}
+
+// sequenceValue returns the value of a column of a repeated sequence, or nil if the line is truncated or the value is missing.
+func sequenceValue(fields []string, position int, elementType string) interface{} {
+	if position >= len(fields) || fields[position] == "NA" {
+		return nil
+	}
+	switch elementType {
+	case "int":
+		if value, err := strconv.Atoi(fields[position]); err == nil {
+			return value
+		}
+		return nil
+	case "float64":
+		if value, err := strconv.ParseFloat(fields[position], 64); err == nil {
+			return value
+		}
+		return nil
+	}
+	return fields[position]
+}
+
+/*
+Grouped returns a copy of the set whose layout of a fileLineType is built from a DataKeyMap entry of its own instead of
+the entry in util.DataKeyMap, see parser.WithGrouping: the DataKey and HeaderDisallow columns of the entry are left out
+of the header and the HeaderDisallow columns are data fields. The other line types keep the layouts of the set.
+*/
+func (s *LineTypeSet) Grouped(fileLineType string, entry util.DataKeyEntry) (*LineTypeSet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	l, ok := s.layouts[fileLineType]
+	if !ok {
+		return nil, errors.New("Grouped: Unknown file_line type:" + fileLineType)
+	}
+	lineLayout, err := newLayout(l.fileType, fileLineType, l.headerFields, l.dataFields, l.table, entry)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", s.version, fileLineType, err)
+	}
+	grouped := &LineTypeSet{
+		version:       s.version,
+		columnDefsUrl: s.columnDefsUrl,
+		lineTypes:     slices.Clone(s.lineTypes),
+		headerColumns: maps.Clone(s.headerColumns),
+		layouts:       maps.Clone(s.layouts),
+	}
+	grouped.layouts[fileLineType] = lineLayout
+	return grouped, nil
+}
+
+// Version returns the parser version of the line types i.e. v12_0.
+func (s *LineTypeSet) Version() string {
+	return s.version
+}
+
+// GetDocForId returns a new document with the header and the data of one data line.
+func (s *LineTypeSet) GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {
+	l, ok := s.layout(fileLineType)
+	if !ok {
+		return nil, errors.New("GetDocForId: Unknown file_line type:" + fileLineType)
+	}
+	doc := make(map[string]interface{}, len(metaDataMap)+len(l.header)+2)
+	// add the metadata to the doc
+	for key, value := range metaDataMap {
+		doc[key] = value
+	}
+	l.fillHeader(fileLineType, headerData, &doc)
+	data := reflect.MakeMap(l.mapType)
+	data.SetMapIndex(reflect.ValueOf(dataKey), l.newData(dataData))
+	doc["data"] = data.Interface()
+	return doc, nil
+}
+
+// AddDataElement adds the data of one data line to an existing document.
+func (s *LineTypeSet) AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {
+	l, ok := s.layout(fileLineType)
+	if !ok {
+		return nil, errors.New("AddDataElement: Unknown file_line type:" + fileLineType)
+	}
+	// a document whose data section is not of this line type is left as it is, like the generated code does
+	if data := reflect.ValueOf((*doc)["data"]); data.IsValid() && data.Type() == l.mapType && !data.IsNil() {
+		data.SetMapIndex(reflect.ValueOf(dataKey), l.newData(dataData))
+	}
+	return *doc, nil
+}
+
+// ColumnDefsUrl returns the URL of the met_header_columns file of the version.
+func (s *LineTypeSet) ColumnDefsUrl() string {
+	return s.columnDefsUrl
+}
+
+// LineTypes returns the supported fileLineTypes i.e. STAT_CNT, in sorted order.
+func (s *LineTypeSet) LineTypes() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.lineTypes)
+}
+
+// HeaderColumns returns the header columns of a fileLineType in the met_header_columns file, or nil for an unknown fileLineType.
+func (s *LineTypeSet) HeaderColumns(fileLineType string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.headerColumns[fileLineType])
+}
+
+// layout returns the layout of a fileLineType, only the set of the custom line types is locked.
+func (s *LineTypeSet) layout(fileLineType string) (*layout, bool) {
+	if s.custom {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+	l, ok := s.layouts[fileLineType]
+	return l, ok
+}
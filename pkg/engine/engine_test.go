@@ -0,0 +1,299 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_0"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_1"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_0"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_1"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v12_0"
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+// testValue returns a value of a column for a test line, every seventh value is missing.
+func testValue(i int, dataType string) string {
+	switch {
+	case i%7 == 3:
+		return "NA"
+	case dataType == "int":
+		return strconv.Itoa(i)
+	case dataType == "float64":
+		return strconv.Itoa(i) + ".25"
+	}
+	return "value" + strconv.Itoa(i)
+}
+
+// testLine returns the header data and the data of a test line of a line type without repeated sequences.
+func testLine(set *LineTypeSet, fileLineType string) ([]string, []string) {
+	l := set.layouts[fileLineType]
+	headerData := make([]string, len(set.headerColumns[fileLineType]))
+	for i := range headerData {
+		headerData[i] = testValue(i, "string")
+	}
+	for _, column := range l.header {
+		headerData[column.index] = testValue(column.index, column.dataType)
+	}
+	dataData := make([]string, len(l.data))
+	for i, column := range l.data {
+		dataData[i] = testValue(i, column.dataType)
+	}
+	return headerData, dataData
+}
+
+// hasSequence returns true if a line type has a repeated sequence.
+func hasSequence(l *layout) bool {
+	for _, column := range l.data {
+		if column.dataType == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func marshal(t *testing.T, doc map[string]interface{}) string {
+	t.Helper()
+	content, err := json.Marshal(doc)
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestDataStructs(t *testing.T) {
+	// the data structs of the engine have the fields of the generated data structs, in the same order
+	for _, version := range util.LineTypeSetVersions() {
+		generated, err := util.GetLineTypeSet(version)
+		assert.NoError(t, err)
+		set, err := New(version)
+		if !assert.NoError(t, err, version) {
+			continue
+		}
+		assert.Equal(t, generated.LineTypes(), set.LineTypes(), version)
+		for _, fileLineType := range generated.LineTypes() {
+			assert.Equal(t, generated.HeaderColumns(fileLineType), set.HeaderColumns(fileLineType), fileLineType)
+			headerData, _ := testLine(set, fileLineType)
+			doc, err := generated.GetDocForId(fileLineType, nil, headerData, nil, "key")
+			if !assert.NoError(t, err) {
+				continue
+			}
+			want := reflect.TypeOf(doc["data"]).Elem()
+			got := set.layouts[fileLineType].structType
+			if !assert.Equal(t, want.NumField(), got.NumField(), "%s %s", version, fileLineType) {
+				continue
+			}
+			for i := range want.NumField() {
+				assert.Equal(t, want.Field(i).Name, got.Field(i).Name, "%s %s", version, fileLineType)
+				assert.Equal(t, want.Field(i).Type, got.Field(i).Type, "%s %s %s", version, fileLineType, want.Field(i).Name)
+				assert.Equal(t, want.Field(i).Tag, got.Field(i).Tag, "%s %s %s", version, fileLineType, want.Field(i).Name)
+			}
+		}
+	}
+}
+
+func TestGetDocForId(t *testing.T) {
+	// the line types without repeated sequences give the same documents as the generated code
+	metaData := map[string]interface{}{"ID": "MET:DD:MET:test", "type": "DD"}
+	for _, version := range util.LineTypeSetVersions() {
+		generated, _ := util.GetLineTypeSet(version)
+		set, err := Get(version)
+		if !assert.NoError(t, err, version) {
+			continue
+		}
+		for _, fileLineType := range set.LineTypes() {
+			if hasSequence(set.layouts[fileLineType]) {
+				continue
+			}
+			headerData, dataData := testLine(set, fileLineType)
+			want, err := generated.GetDocForId(fileLineType, metaData, headerData, dataData, "key1")
+			assert.NoError(t, err)
+			got, err := set.GetDocForId(fileLineType, metaData, headerData, dataData, "key1")
+			assert.NoError(t, err)
+			// a shorter line is added to the same document
+			_, err = generated.AddDataElement("key2", fileLineType, dataData[:len(dataData)/2], &want)
+			assert.NoError(t, err)
+			_, err = set.AddDataElement("key2", fileLineType, dataData[:len(dataData)/2], &got)
+			assert.NoError(t, err)
+			assert.JSONEq(t, marshal(t, want), marshal(t, got), "%s %s", version, fileLineType)
+		}
+	}
+}
+
+func TestRepeatedSequences(t *testing.T) {
+	set, err := Get("v12_0")
+	assert.NoError(t, err)
+	header := make([]string, len(set.HeaderColumns("STAT_PCT")))
+	for i := range header {
+		header[i] = "NA"
+	}
+	tests := []struct {
+		fileLineType string
+		data         string
+		field        string
+		want         map[string]interface{}
+		after        map[string]interface{} // the fields after the sequences
+	}{
+		{
+			fileLineType: "STAT_PCT",
+			data:         "100 2 0.1 10 20 0.5 30 NA",
+			field:        "thresh",
+			want:         map[string]interface{}{"THRESH_1": 0.1, "OY_1": 10.0, "ON_1": 20.0, "THRESH_2": 0.5, "OY_2": 30.0, "ON_2": nil},
+		},
+		{
+			fileLineType: "STAT_MCTC",
+			data:         "100 2 1 2 3 4 0.25",
+			field:        "cat",
+			want:         map[string]interface{}{"F1_O1": 1.0, "F1_O2": 2.0, "F2_O1": 3.0, "F2_O2": 4.0},
+			after:        map[string]interface{}{"ecValue": 0.25},
+		},
+		{
+			fileLineType: "STAT_PSTD",
+			data:         "100 2 0.1 0.5 0.2 0.3 NA NA NA NA NA NA NA NA NA NA NA NA",
+			field:        "thresh",
+			want:         map[string]interface{}{"THRESH_1": 0.1, "THRESH_2": 0.5},
+			after:        map[string]interface{}{"baserNcl": 0.2, "baserNcu": 0.3},
+		},
+		{
+			// a truncated line has nil values
+			fileLineType: "STAT_RHIST",
+			data:         "100 3 5 6",
+			field:        "rank",
+			want:         map[string]interface{}{"RANK_1": 5.0, "RANK_2": 6.0, "RANK_3": nil},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.fileLineType, func(t *testing.T) {
+			doc, err := set.GetDocForId(test.fileLineType, nil, header, strings.Fields(test.data), "key")
+			assert.NoError(t, err)
+			var parsed struct {
+				Data map[string]map[string]interface{} `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal([]byte(marshal(t, doc)), &parsed))
+			data := parsed.Data["key"]
+			assert.Equal(t, test.want, data[test.field])
+			assert.Equal(t, 100.0, data["total"])
+			for field, value := range test.after {
+				assert.Equal(t, value, data[field], field)
+			}
+		})
+	}
+}
+
+func TestUnknownLineType(t *testing.T) {
+	set, err := Get("v12_0")
+	assert.NoError(t, err)
+	_, err = set.GetDocForId("STAT_NOPE", nil, nil, nil, "key")
+	assert.EqualError(t, err, "GetDocForId: Unknown file_line type:STAT_NOPE")
+	doc := map[string]interface{}{}
+	_, err = set.AddDataElement("key", "STAT_NOPE", nil, &doc)
+	assert.EqualError(t, err, "AddDataElement: Unknown file_line type:STAT_NOPE")
+	_, err = Get("v9_0")
+	assert.ErrorIs(t, err, util.ErrUnsupportedVersion)
+}
+
+func TestGrouped(t *testing.T) {
+	set, err := Get("v12_0")
+	assert.NoError(t, err)
+	// OBTYPE is moved from the header to the end of the data and FCST_LEAD, which is not a data key, is in the header
+	grouped, err := set.Grouped("STAT_SL1L2", util.DataKeyEntry{DataKey: []string{"VX_MASK"}, HeaderDisallow: []string{"OBTYPE"}})
+	assert.NoError(t, err)
+	headerData := make([]string, len(grouped.HeaderColumns("STAT_SL1L2")))
+	headerData[3] = "120000"
+	doc, err := grouped.GetDocForId("STAT_SL1L2", nil, headerData, []string{"10", "1.5", "2.5", "3.5", "4.5", "5.5", "6.5", "ADPSFC"}, "WATER")
+	assert.NoError(t, err)
+	assert.Equal(t, 120000, doc["FCST_LEAD"])
+	assert.NotContains(t, doc, "OBTYPE")
+	assert.Contains(t, marshal(t, doc), `"obtype":"ADPSFC"`)
+	// the set itself and the other line types keep their layouts
+	assert.Equal(t, set.layouts["STAT_CNT"], grouped.layouts["STAT_CNT"])
+	doc, err = set.GetDocForId("STAT_SL1L2", nil, headerData, []string{"10"}, "120000")
+	assert.NoError(t, err)
+	assert.NotContains(t, doc, "FCST_LEAD")
+	_, err = set.Grouped("STAT_NOPE", util.DataKeyEntry{DataKey: []string{"VX_MASK"}})
+	assert.EqualError(t, err, "Grouped: Unknown file_line type:STAT_NOPE")
+	// a disallowed column cannot be a data field twice
+	_, err = set.Grouped("STAT_SL1L2", util.DataKeyEntry{DataKey: []string{"VX_MASK"}, HeaderDisallow: []string{"TOTAL"}})
+	assert.Error(t, err)
+}
+
+func TestRegisterCustomLineType(t *testing.T) {
+	custom := util.CustomLineType{
+		FileType:    "STAT",
+		LineType:    "ENGINE_SCORES",
+		Columns:     "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG LINE_TYPE TOTAL (N_THRESH) THRESH_[0-9]* SCORE_[0-9]* MEAN",
+		ColumnTypes: map[string]string{"TOTAL": "int", "MEAN": "float64"},
+		RepeatingGroups: []util.RepeatingGroup{{
+			Term:        "(N_THRESH)",
+			CountType:   "int",
+			StructField: "THRESH",
+			Sequences:   []util.GroupSequence{{KeyPrefixes: []string{"THRESH_", "SCORE_"}, ElementType: "float64"}},
+		}},
+		DataKey: util.DataKeyEntry{DataKey: []string{"FCST_LEAD"}},
+	}
+	assert.NoError(t, util.RegisterCustomLineType(custom))
+	assert.ErrorIs(t, util.RegisterCustomLineType(custom), util.ErrInvalidLineType)
+	// a column that is not a field name is rejected before the line type is registered
+	invalid := custom
+	invalid.LineType = "ENGINE_INVALID"
+	invalid.Columns = "VERSION MODEL FCST_LEAD LINE_TYPE TOTAL total"
+	assert.ErrorIs(t, util.RegisterCustomLineType(invalid), util.ErrInvalidLineType)
+	assert.False(t, util.IsCustomLineType("STAT_ENGINE_INVALID"))
+
+	set := Custom()
+	assert.Equal(t, CustomVersion, set.Version())
+	assert.Contains(t, set.LineTypes(), "STAT_ENGINE_SCORES")
+	assert.Equal(t, strings.Fields("VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG LINE_TYPE"), set.HeaderColumns("STAT_ENGINE_SCORES"))
+	// the header data of the parser, the data key column is blanked out and the dates are epochs
+	header := []string{"V12.0.0", "GFS", "NA", "", "1730743200", "ENGINE_SCORES"}
+	doc, err := set.GetDocForId("STAT_ENGINE_SCORES", nil, header, strings.Fields("10 2 0.1 0.7 0.5 0.9 0.75"), "060000")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"VERSION": "V12.0.0", "MODEL": "GFS", "DESC": null, "FCST_VALID_BEG": 1730743200, "LINE_TYPE": "ENGINE_SCORES",
+		"data": {"060000": {"total": 10, "thresh": {"THRESH_1": 0.1, "SCORE_1": 0.7, "THRESH_2": 0.5, "SCORE_2": 0.9}, "mean": 0.75}}
+	}`, marshal(t, doc))
+	// the custom line types are not in the sets of the MET versions
+	metSet, err := Get("v12_0")
+	assert.NoError(t, err)
+	_, err = metSet.GetDocForId("STAT_ENGINE_SCORES", nil, header, nil, "060000")
+	assert.Error(t, err)
+}
+
+// benchmarkGetDocForId parses the test line of a v12_0 line type with a LineTypeSet.
+func benchmarkGetDocForId(b *testing.B, set util.LineTypeSet, fileLineType string) {
+	engineSet, err := Get("v12_0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	headerData, dataData := testLine(engineSet, fileLineType)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		if _, err := set.GetDocForId(fileLineType, nil, headerData, dataData, fmt.Sprint(i%8)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetDocForId(b *testing.B) {
+	generated, err := util.GetLineTypeSet("v12_0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	engineSet, err := Get("v12_0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, fileLineType := range []string{"STAT_CNT", "STAT_FHO", "MODE_OBJ", "TCST_TCMPR"} {
+		b.Run(fileLineType+"/generated", func(b *testing.B) {
+			benchmarkGetDocForId(b, generated, fileLineType)
+		})
+		b.Run(fileLineType+"/engine", func(b *testing.B) {
+			benchmarkGetDocForId(b, engineSet, fileLineType)
+		})
+	}
+}
@@ -0,0 +1,8579 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+THIS CODE IS AUTOMATICALLY GENERATED - DO NOT EDIT THIS CODE
+To modify this code - modify the generator.go file and run the generator.go program
+cd  <repo_root>
+go run ./generator -linetypes-dir=pkg/linetypes
+
+This package has the line types whose code is the same in most of the MET versions that have them,
+the version packages i.e. v12_0 have type aliases for them and the line types that differ.
+*/
+
+func GetLeadFromInitValid(data []string, dataFieldIndex int) string {
+	initTime, _ := time.Parse("20060102_150405", data[dataFieldIndex-1])
+	validTime, _ := time.Parse("20060102_150405", data[dataFieldIndex+1])
+	lead := validTime.Sub(initTime)
+	return strconv.FormatInt(int64(lead.Hours()), 10)
+}
+
+func SetValueForField(doc *map[string]interface{}, fileType string, term string, i int, dataLen int, fields []string, fieldIndex int, dataType string) {
+	if term == "INIT" && fileType != "TCST" {
+		// do not assign any value to the INIT field for TCST files
+		// The INIT field needs to be moved from the header to the data section.
+		return
+	}
+	if term == "LEAD" && fileType == "TCST" {
+		// This is a special case for TCST files.
+		// if the TERM is LEAD and the lead is NA then we
+		// have to get the lead from the init and valid fields.
+		// INIT is the prior field and VALID is the next field from LEAD.
+		// the init and valid fields are in the format YYYYMMDD_HHMMSS
+		// the lead is the difference between the valid and the init
+		// in hours
+		(*doc)["LEAD"], _ = strconv.Atoi(GetLeadFromInitValid(fields, fieldIndex))
+		return
+	}
+	if i <= dataLen && fields[fieldIndex] != "" && fields[fieldIndex] != "NA" {
+		switch dataType {
+		case "int":
+			(*doc)[term], _ = strconv.Atoi(fields[fieldIndex])
+			return
+		case "float64":
+			(*doc)[term], _ = strconv.ParseFloat(fields[fieldIndex], 64)
+			return
+		case "string":
+			(*doc)[term] = fields[fieldIndex]
+			return
+		default:
+			(*doc)[term] = fields[fieldIndex]
+			return
+		}
+	}
+	// a missing value is nil, the parser decides if it is left out, written as null or as a sentinel value
+	(*doc)[term] = nil
+}
+
+// ParseIntField returns a pointer to the value of an int data field, or nil if the field is missing i.e. "NA",
+// so that a real 0 is kept in the JSON output
+func ParseIntField(field string) *int {
+	value, err := strconv.Atoi(field)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// ParseFloatField returns a pointer to the value of a float64 data field, or nil if the field is missing i.e. "NA",
+// so that a real 0 is kept in the JSON output
+func ParseFloatField(field string) *float64 {
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// Header struct definitions
+type MODE_CTS_header struct {
+	VERSION    *string  `json:"VERSION,omitempty"`
+	MODEL      *string  `json:"MODEL,omitempty"`
+	N_VALID    *int     `json:"N_VALID,omitempty"`
+	GRID_RES   *float64 `json:"GRID_RES,omitempty"`
+	DESC       *string  `json:"DESC,omitempty"`
+	FCST_VALID *string  `json:"FCST_VALID,omitempty"`
+	FCST_ACCUM *string  `json:"FCST_ACCUM,omitempty"`
+	OBS_LEAD   *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID  *string  `json:"OBS_VALID,omitempty"`
+	OBS_ACCUM  *string  `json:"OBS_ACCUM,omitempty"`
+	FCST_RAD   *int     `json:"FCST_RAD,omitempty"`
+	FCST_THR   *string  `json:"FCST_THR,omitempty"`
+	OBS_RAD    *int     `json:"OBS_RAD,omitempty"`
+	OBS_THR    *string  `json:"OBS_THR,omitempty"`
+	FCST_VAR   *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV   *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR    *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS  *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV    *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE     *string  `json:"OBTYPE,omitempty"`
+	LINE_TYPE  *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type MODE_OBJ_header struct {
+	VERSION    *string  `json:"VERSION,omitempty"`
+	MODEL      *string  `json:"MODEL,omitempty"`
+	N_VALID    *int     `json:"N_VALID,omitempty"`
+	GRID_RES   *float64 `json:"GRID_RES,omitempty"`
+	DESC       *string  `json:"DESC,omitempty"`
+	FCST_VALID *string  `json:"FCST_VALID,omitempty"`
+	FCST_ACCUM *string  `json:"FCST_ACCUM,omitempty"`
+	OBS_LEAD   *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID  *string  `json:"OBS_VALID,omitempty"`
+	OBS_ACCUM  *string  `json:"OBS_ACCUM,omitempty"`
+	FCST_RAD   *int     `json:"FCST_RAD,omitempty"`
+	FCST_THR   *string  `json:"FCST_THR,omitempty"`
+	OBS_RAD    *int     `json:"OBS_RAD,omitempty"`
+	OBS_THR    *string  `json:"OBS_THR,omitempty"`
+	FCST_VAR   *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV   *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR    *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS  *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV    *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE     *string  `json:"OBTYPE,omitempty"`
+	LINE_TYPE  *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_CNT_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_CTC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_CTS_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_DMAP_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_ECLV_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_ECNT_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_FHO_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_GENMPR_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_GRAD_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_ISC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_MCTC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_MCTS_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_MPR_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_NBRCNT_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_NBRCTC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_NBRCTS_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_ORANK_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_PCT_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_PHIST_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_PJC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_PRC_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_PSTD_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_RELP_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_RHIST_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_RPS_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SAL1L2_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SEEPS_MPR_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SEEPS_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SL1L2_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SSIDX_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_SSVAR_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_VAL1L2_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_VCNT_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type STAT_VL1L2_header struct {
+	VERSION        *string  `json:"VERSION,omitempty"`
+	MODEL          *string  `json:"MODEL,omitempty"`
+	DESC           *string  `json:"DESC,omitempty"`
+	FCST_VALID_BEG *int     `json:"FCST_VALID_BEG,omitempty"`
+	FCST_VALID_END *int     `json:"FCST_VALID_END,omitempty"`
+	OBS_LEAD       *int     `json:"OBS_LEAD,omitempty"`
+	OBS_VALID_BEG  *int     `json:"OBS_VALID_BEG,omitempty"`
+	OBS_VALID_END  *int     `json:"OBS_VALID_END,omitempty"`
+	FCST_VAR       *string  `json:"FCST_VAR,omitempty"`
+	FCST_UNITS     *string  `json:"FCST_UNITS,omitempty"`
+	FCST_LEV       *string  `json:"FCST_LEV,omitempty"`
+	OBS_VAR        *string  `json:"OBS_VAR,omitempty"`
+	OBS_UNITS      *string  `json:"OBS_UNITS,omitempty"`
+	OBS_LEV        *string  `json:"OBS_LEV,omitempty"`
+	OBTYPE         *string  `json:"OBTYPE,omitempty"`
+	VX_MASK        *string  `json:"VX_MASK,omitempty"`
+	INTERP_MTHD    *string  `json:"INTERP_MTHD,omitempty"`
+	INTERP_PNTS    *int     `json:"INTERP_PNTS,omitempty"`
+	FCST_THRESH    *string  `json:"FCST_THRESH,omitempty"`
+	OBS_THRESH     *string  `json:"OBS_THRESH,omitempty"`
+	COV_THRESH     *string  `json:"COV_THRESH,omitempty"`
+	ALPHA          *float64 `json:"ALPHA,omitempty"`
+	LINE_TYPE      *string  `json:"LINE_TYPE,omitempty"`
+}
+
+type TCST_PROBRIRW_header struct {
+	VERSION    *string `json:"VERSION,omitempty"`
+	AMODEL     *string `json:"AMODEL,omitempty"`
+	BMODEL     *string `json:"BMODEL,omitempty"`
+	DESC       *string `json:"DESC,omitempty"`
+	STORM_ID   *string `json:"STORM_ID,omitempty"`
+	BASIN      *string `json:"BASIN,omitempty"`
+	CYCLONE    *string `json:"CYCLONE,omitempty"`
+	STORM_NAME *string `json:"STORM_NAME,omitempty"`
+	VALID      *int    `json:"VALID,omitempty"`
+	INIT_MASK  *string `json:"INIT_MASK,omitempty"`
+	VALID_MASK *string `json:"VALID_MASK,omitempty"`
+	LINE_TYPE  *string `json:"LINE_TYPE,omitempty"`
+}
+
+type TCST_TCDIAG_header struct {
+	VERSION    *string `json:"VERSION,omitempty"`
+	AMODEL     *string `json:"AMODEL,omitempty"`
+	BMODEL     *string `json:"BMODEL,omitempty"`
+	DESC       *string `json:"DESC,omitempty"`
+	STORM_ID   *string `json:"STORM_ID,omitempty"`
+	BASIN      *string `json:"BASIN,omitempty"`
+	CYCLONE    *string `json:"CYCLONE,omitempty"`
+	STORM_NAME *string `json:"STORM_NAME,omitempty"`
+	VALID      *int    `json:"VALID,omitempty"`
+	INIT_MASK  *string `json:"INIT_MASK,omitempty"`
+	VALID_MASK *string `json:"VALID_MASK,omitempty"`
+	LINE_TYPE  *string `json:"LINE_TYPE,omitempty"`
+}
+
+type TCST_TCMPR_header struct {
+	VERSION    *string `json:"VERSION,omitempty"`
+	AMODEL     *string `json:"AMODEL,omitempty"`
+	BMODEL     *string `json:"BMODEL,omitempty"`
+	DESC       *string `json:"DESC,omitempty"`
+	STORM_ID   *string `json:"STORM_ID,omitempty"`
+	BASIN      *string `json:"BASIN,omitempty"`
+	CYCLONE    *string `json:"CYCLONE,omitempty"`
+	STORM_NAME *string `json:"STORM_NAME,omitempty"`
+	VALID      *int    `json:"VALID,omitempty"`
+	INIT_MASK  *string `json:"INIT_MASK,omitempty"`
+	VALID_MASK *string `json:"VALID_MASK,omitempty"`
+	LINE_TYPE  *string `json:"LINE_TYPE,omitempty"`
+}
+
+// fillHeader functions
+func (s *MODE_CTS) fill_MODE_CTS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "MODE", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "MODE", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "MODE", "N_VALID", i, dataLen, fields, 2, "int")
+	i++
+	SetValueForField(doc, "MODE", "GRID_RES", i, dataLen, fields, 3, "float64")
+	i++
+	SetValueForField(doc, "MODE", "DESC", i, dataLen, fields, 4, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_VALID", i, dataLen, fields, 6, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_ACCUM", i, dataLen, fields, 7, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_LEAD", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "MODE", "OBS_VALID", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_ACCUM", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_RAD", i, dataLen, fields, 11, "int")
+	i++
+	SetValueForField(doc, "MODE", "FCST_THR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_RAD", i, dataLen, fields, 13, "int")
+	i++
+	SetValueForField(doc, "MODE", "OBS_THR", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_VAR", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_UNITS", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_LEV", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_VAR", i, dataLen, fields, 18, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_UNITS", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_LEV", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBTYPE", i, dataLen, fields, 21, "string")
+	(*doc)["LINE_TYPE"] = "MODE_CTS"
+}
+
+func (s *MODE_OBJ) fill_MODE_OBJ_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "MODE", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "MODE", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "MODE", "N_VALID", i, dataLen, fields, 2, "int")
+	i++
+	SetValueForField(doc, "MODE", "GRID_RES", i, dataLen, fields, 3, "float64")
+	i++
+	SetValueForField(doc, "MODE", "DESC", i, dataLen, fields, 4, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_VALID", i, dataLen, fields, 6, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_ACCUM", i, dataLen, fields, 7, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_LEAD", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "MODE", "OBS_VALID", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_ACCUM", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_RAD", i, dataLen, fields, 11, "int")
+	i++
+	SetValueForField(doc, "MODE", "FCST_THR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_RAD", i, dataLen, fields, 13, "int")
+	i++
+	SetValueForField(doc, "MODE", "OBS_THR", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_VAR", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_UNITS", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "MODE", "FCST_LEV", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_VAR", i, dataLen, fields, 18, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_UNITS", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBS_LEV", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "MODE", "OBTYPE", i, dataLen, fields, 21, "string")
+	(*doc)["LINE_TYPE"] = "MODE_OBJ"
+}
+
+func (s *STAT_CNT) fill_STAT_CNT_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_CTC) fill_STAT_CTC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_CTS) fill_STAT_CTS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_DMAP) fill_STAT_DMAP_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_ECLV) fill_STAT_ECLV_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_ECNT) fill_STAT_ECNT_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_FHO) fill_STAT_FHO_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_GENMPR) fill_STAT_GENMPR_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_GRAD) fill_STAT_GRAD_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_ISC) fill_STAT_ISC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_MCTC) fill_STAT_MCTC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_MCTS) fill_STAT_MCTS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_MPR) fill_STAT_MPR_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_NBRCNT) fill_STAT_NBRCNT_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_NBRCTC) fill_STAT_NBRCTC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_NBRCTS) fill_STAT_NBRCTS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_ORANK) fill_STAT_ORANK_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_PCT) fill_STAT_PCT_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_PHIST) fill_STAT_PHIST_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_PJC) fill_STAT_PJC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_PRC) fill_STAT_PRC_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_PSTD) fill_STAT_PSTD_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_RELP) fill_STAT_RELP_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_RHIST) fill_STAT_RHIST_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_RPS) fill_STAT_RPS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SAL1L2) fill_STAT_SAL1L2_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SEEPS_MPR) fill_STAT_SEEPS_MPR_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SEEPS) fill_STAT_SEEPS_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SL1L2) fill_STAT_SL1L2_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SSIDX) fill_STAT_SSIDX_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_SSVAR) fill_STAT_SSVAR_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_VAL1L2) fill_STAT_VAL1L2_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_VCNT) fill_STAT_VCNT_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *STAT_VL1L2) fill_STAT_VL1L2_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "STAT", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "STAT", "MODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "STAT", "DESC", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_BEG", i, dataLen, fields, 4, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VALID_END", i, dataLen, fields, 5, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEAD", i, dataLen, fields, 6, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_BEG", i, dataLen, fields, 7, "int")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VALID_END", i, dataLen, fields, 8, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_VAR", i, dataLen, fields, 9, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_UNITS", i, dataLen, fields, 10, "string")
+	i++
+	SetValueForField(doc, "STAT", "FCST_LEV", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_VAR", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_UNITS", i, dataLen, fields, 13, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_LEV", i, dataLen, fields, 14, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBTYPE", i, dataLen, fields, 15, "string")
+	i++
+	SetValueForField(doc, "STAT", "VX_MASK", i, dataLen, fields, 16, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_MTHD", i, dataLen, fields, 17, "string")
+	i++
+	SetValueForField(doc, "STAT", "INTERP_PNTS", i, dataLen, fields, 18, "int")
+	i++
+	SetValueForField(doc, "STAT", "FCST_THRESH", i, dataLen, fields, 19, "string")
+	i++
+	SetValueForField(doc, "STAT", "OBS_THRESH", i, dataLen, fields, 20, "string")
+	i++
+	SetValueForField(doc, "STAT", "COV_THRESH", i, dataLen, fields, 21, "string")
+	i++
+	SetValueForField(doc, "STAT", "ALPHA", i, dataLen, fields, 22, "float64")
+	i++
+	SetValueForField(doc, "STAT", "LINE_TYPE", i, dataLen, fields, 23, "string")
+}
+
+func (s *TCST_PROBRIRW) fill_TCST_PROBRIRW_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "TCST", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "TCST", "AMODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "TCST", "BMODEL", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "TCST", "DESC", i, dataLen, fields, 3, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_ID", i, dataLen, fields, 4, "string")
+	i++
+	SetValueForField(doc, "TCST", "BASIN", i, dataLen, fields, 5, "string")
+	i++
+	SetValueForField(doc, "TCST", "CYCLONE", i, dataLen, fields, 6, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_NAME", i, dataLen, fields, 7, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID", i, dataLen, fields, 10, "int")
+	i++
+	SetValueForField(doc, "TCST", "INIT_MASK", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID_MASK", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "TCST", "LINE_TYPE", i, dataLen, fields, 13, "string")
+}
+
+func (s *TCST_TCDIAG) fill_TCST_TCDIAG_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "TCST", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "TCST", "AMODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "TCST", "BMODEL", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "TCST", "DESC", i, dataLen, fields, 3, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_ID", i, dataLen, fields, 4, "string")
+	i++
+	SetValueForField(doc, "TCST", "BASIN", i, dataLen, fields, 5, "string")
+	i++
+	SetValueForField(doc, "TCST", "CYCLONE", i, dataLen, fields, 6, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_NAME", i, dataLen, fields, 7, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID", i, dataLen, fields, 10, "int")
+	i++
+	SetValueForField(doc, "TCST", "INIT_MASK", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID_MASK", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "TCST", "LINE_TYPE", i, dataLen, fields, 13, "string")
+}
+
+func (s *TCST_TCMPR) fill_TCST_TCMPR_Header(fields []string, doc *map[string]interface{}) {
+	dataLen := len(fields)
+	i := -1
+	// fill the met fields leaving out "" and NA values
+	i++
+	SetValueForField(doc, "TCST", "VERSION", i, dataLen, fields, 0, "string")
+	i++
+	SetValueForField(doc, "TCST", "AMODEL", i, dataLen, fields, 1, "string")
+	i++
+	SetValueForField(doc, "TCST", "BMODEL", i, dataLen, fields, 2, "string")
+	i++
+	SetValueForField(doc, "TCST", "DESC", i, dataLen, fields, 3, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_ID", i, dataLen, fields, 4, "string")
+	i++
+	SetValueForField(doc, "TCST", "BASIN", i, dataLen, fields, 5, "string")
+	i++
+	SetValueForField(doc, "TCST", "CYCLONE", i, dataLen, fields, 6, "string")
+	i++
+	SetValueForField(doc, "TCST", "STORM_NAME", i, dataLen, fields, 7, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID", i, dataLen, fields, 10, "int")
+	i++
+	SetValueForField(doc, "TCST", "INIT_MASK", i, dataLen, fields, 11, "string")
+	i++
+	SetValueForField(doc, "TCST", "VALID_MASK", i, dataLen, fields, 12, "string")
+	i++
+	SetValueForField(doc, "TCST", "LINE_TYPE", i, dataLen, fields, 13, "string")
+}
+
+//line data struct definitions
+type MODE_CTS struct {
+	FIELD   string   `json:"field,omitempty"`
+	TOTAL   *int     `json:"total,omitempty"`
+	FY_OY   *float64 `json:"fyOy,omitempty"`
+	FY_ON   *float64 `json:"fyOn,omitempty"`
+	FN_OY   *float64 `json:"fnOy,omitempty"`
+	FN_ON   *float64 `json:"fnOn,omitempty"`
+	BASER   *float64 `json:"baser,omitempty"`
+	FMEAN   *float64 `json:"fmean,omitempty"`
+	ACC     *float64 `json:"acc,omitempty"`
+	FBIAS   *float64 `json:"fbias,omitempty"`
+	PODY    *float64 `json:"pody,omitempty"`
+	PODN    *float64 `json:"podn,omitempty"`
+	POFD    *float64 `json:"pofd,omitempty"`
+	FAR     *float64 `json:"far,omitempty"`
+	CSI     *float64 `json:"csi,omitempty"`
+	GSS     *float64 `json:"gss,omitempty"`
+	HK      *float64 `json:"hk,omitempty"`
+	HSS     *float64 `json:"hss,omitempty"`
+	ODDS    *float64 `json:"odds,omitempty"`
+	MISSING []string `json:"missing,omitempty"`
+}
+
+type MODE_OBJ struct {
+	OBJECT_ID                  string   `json:"objectId,omitempty"`
+	OBJECT_CAT                 string   `json:"objectCat,omitempty"`
+	CENTROID_X                 *float64 `json:"centroidX,omitempty"`
+	CENTROID_Y                 *float64 `json:"centroidY,omitempty"`
+	CENTROID_LAT               *float64 `json:"centroidLat,omitempty"`
+	CENTROID_LON               *float64 `json:"centroidLon,omitempty"`
+	AXIS_ANG                   *float64 `json:"axisAng,omitempty"`
+	LENGTH                     *float64 `json:"length,omitempty"`
+	WIDTH                      *float64 `json:"width,omitempty"`
+	AREA                       *int     `json:"area,omitempty"`
+	AREA_THRESH                *int     `json:"areaThresh,omitempty"`
+	CURVATURE                  *float64 `json:"curvature,omitempty"`
+	CURVATURE_X                *float64 `json:"curvatureX,omitempty"`
+	CURVATURE_Y                *float64 `json:"curvatureY,omitempty"`
+	COMPLEXITY                 *float64 `json:"complexity,omitempty"`
+	INTENSITY_10               *float64 `json:"intensity10,omitempty"`
+	INTENSITY_25               *float64 `json:"intensity25,omitempty"`
+	INTENSITY_50               *float64 `json:"intensity50,omitempty"`
+	INTENSITY_75               *float64 `json:"intensity75,omitempty"`
+	INTENSITY_90               *float64 `json:"intensity90,omitempty"`
+	INTENSITY_USER             *float64 `json:"intensityUser,omitempty"`
+	INTENSITY_SUM              *float64 `json:"intensitySum,omitempty"`
+	CENTROID_DIST              *float64 `json:"centroidDist,omitempty"`
+	BOUNDARY_DIST              *float64 `json:"boundaryDist,omitempty"`
+	CONVEX_HULL_DIST           *float64 `json:"convexHullDist,omitempty"`
+	ANGLE_DIFF                 *float64 `json:"angleDiff,omitempty"`
+	ASPECT_DIFF                *float64 `json:"aspectDiff,omitempty"`
+	AREA_RATIO                 *float64 `json:"areaRatio,omitempty"`
+	INTERSECTION_AREA          *float64 `json:"intersectionArea,omitempty"`
+	UNION_AREA                 *float64 `json:"unionArea,omitempty"`
+	SYMMETRIC_DIFF             *float64 `json:"symmetricDiff,omitempty"`
+	INTERSECTION_OVER_AREA     *float64 `json:"intersectionOverArea,omitempty"`
+	CURVATURE_RATIO            *float64 `json:"curvatureRatio,omitempty"`
+	COMPLEXITY_RATIO           *float64 `json:"complexityRatio,omitempty"`
+	PERCENTILE_INTENSITY_RATIO *float64 `json:"percentileIntensityRatio,omitempty"`
+	INTEREST                   *float64 `json:"interest,omitempty"`
+	MISSING                    []string `json:"missing,omitempty"`
+}
+
+type STAT_CNT struct {
+	TOTAL                *int     `json:"total,omitempty"`
+	FBAR                 *float64 `json:"fbar,omitempty"`
+	FBAR_NCL             *float64 `json:"fbarNcl,omitempty"`
+	FBAR_NCU             *float64 `json:"fbarNcu,omitempty"`
+	FBAR_BCL             *float64 `json:"fbarBcl,omitempty"`
+	FBAR_BCU             *float64 `json:"fbarBcu,omitempty"`
+	FSTDEV               *float64 `json:"fstdev,omitempty"`
+	FSTDEV_NCL           *float64 `json:"fstdevNcl,omitempty"`
+	FSTDEV_NCU           *float64 `json:"fstdevNcu,omitempty"`
+	FSTDEV_BCL           *float64 `json:"fstdevBcl,omitempty"`
+	FSTDEV_BCU           *float64 `json:"fstdevBcu,omitempty"`
+	OBAR                 *float64 `json:"obar,omitempty"`
+	OBAR_NCL             *float64 `json:"obarNcl,omitempty"`
+	OBAR_NCU             *float64 `json:"obarNcu,omitempty"`
+	OBAR_BCL             *float64 `json:"obarBcl,omitempty"`
+	OBAR_BCU             *float64 `json:"obarBcu,omitempty"`
+	OSTDEV               *float64 `json:"ostdev,omitempty"`
+	OSTDEV_NCL           *float64 `json:"ostdevNcl,omitempty"`
+	OSTDEV_NCU           *float64 `json:"ostdevNcu,omitempty"`
+	OSTDEV_BCL           *float64 `json:"ostdevBcl,omitempty"`
+	OSTDEV_BCU           *float64 `json:"ostdevBcu,omitempty"`
+	PR_CORR              *float64 `json:"prCorr,omitempty"`
+	PR_CORR_NCL          *float64 `json:"prCorrNcl,omitempty"`
+	PR_CORR_NCU          *float64 `json:"prCorrNcu,omitempty"`
+	PR_CORR_BCL          *float64 `json:"prCorrBcl,omitempty"`
+	PR_CORR_BCU          *float64 `json:"prCorrBcu,omitempty"`
+	SP_CORR              *float64 `json:"spCorr,omitempty"`
+	KT_CORR              *float64 `json:"ktCorr,omitempty"`
+	RANKS                *int     `json:"ranks,omitempty"`
+	FRANK_TIES           *int     `json:"frankTies,omitempty"`
+	ORANK_TIES           *int     `json:"orankTies,omitempty"`
+	ME                   *float64 `json:"me,omitempty"`
+	ME_NCL               *float64 `json:"meNcl,omitempty"`
+	ME_NCU               *float64 `json:"meNcu,omitempty"`
+	ME_BCL               *float64 `json:"meBcl,omitempty"`
+	ME_BCU               *float64 `json:"meBcu,omitempty"`
+	ESTDEV               *float64 `json:"estdev,omitempty"`
+	ESTDEV_NCL           *float64 `json:"estdevNcl,omitempty"`
+	ESTDEV_NCU           *float64 `json:"estdevNcu,omitempty"`
+	ESTDEV_BCL           *float64 `json:"estdevBcl,omitempty"`
+	ESTDEV_BCU           *float64 `json:"estdevBcu,omitempty"`
+	MBIAS                *float64 `json:"mbias,omitempty"`
+	MBIAS_BCL            *float64 `json:"mbiasBcl,omitempty"`
+	MBIAS_BCU            *float64 `json:"mbiasBcu,omitempty"`
+	MAE                  *float64 `json:"mae,omitempty"`
+	MAE_BCL              *float64 `json:"maeBcl,omitempty"`
+	MAE_BCU              *float64 `json:"maeBcu,omitempty"`
+	MSE                  *float64 `json:"mse,omitempty"`
+	MSE_BCL              *float64 `json:"mseBcl,omitempty"`
+	MSE_BCU              *float64 `json:"mseBcu,omitempty"`
+	BCMSE                *float64 `json:"bcmse,omitempty"`
+	BCMSE_BCL            *float64 `json:"bcmseBcl,omitempty"`
+	BCMSE_BCU            *float64 `json:"bcmseBcu,omitempty"`
+	RMSE                 *float64 `json:"rmse,omitempty"`
+	RMSE_BCL             *float64 `json:"rmseBcl,omitempty"`
+	RMSE_BCU             *float64 `json:"rmseBcu,omitempty"`
+	E10                  *float64 `json:"e10,omitempty"`
+	E10_BCL              *float64 `json:"e10Bcl,omitempty"`
+	E10_BCU              *float64 `json:"e10Bcu,omitempty"`
+	E25                  *float64 `json:"e25,omitempty"`
+	E25_BCL              *float64 `json:"e25Bcl,omitempty"`
+	E25_BCU              *float64 `json:"e25Bcu,omitempty"`
+	E50                  *float64 `json:"e50,omitempty"`
+	E50_BCL              *float64 `json:"e50Bcl,omitempty"`
+	E50_BCU              *float64 `json:"e50Bcu,omitempty"`
+	E75                  *float64 `json:"e75,omitempty"`
+	E75_BCL              *float64 `json:"e75Bcl,omitempty"`
+	E75_BCU              *float64 `json:"e75Bcu,omitempty"`
+	E90                  *float64 `json:"e90,omitempty"`
+	E90_BCL              *float64 `json:"e90Bcl,omitempty"`
+	E90_BCU              *float64 `json:"e90Bcu,omitempty"`
+	EIQR                 *float64 `json:"eiqr,omitempty"`
+	EIQR_BCL             *float64 `json:"eiqrBcl,omitempty"`
+	EIQR_BCU             *float64 `json:"eiqrBcu,omitempty"`
+	MAD                  *float64 `json:"mad,omitempty"`
+	MAD_BCL              *float64 `json:"madBcl,omitempty"`
+	MAD_BCU              *float64 `json:"madBcu,omitempty"`
+	ANOM_CORR            *float64 `json:"anomCorr,omitempty"`
+	ANOM_CORR_NCL        *float64 `json:"anomCorrNcl,omitempty"`
+	ANOM_CORR_NCU        *float64 `json:"anomCorrNcu,omitempty"`
+	ANOM_CORR_BCL        *float64 `json:"anomCorrBcl,omitempty"`
+	ANOM_CORR_BCU        *float64 `json:"anomCorrBcu,omitempty"`
+	ME2                  *float64 `json:"me2,omitempty"`
+	ME2_BCL              *float64 `json:"me2Bcl,omitempty"`
+	ME2_BCU              *float64 `json:"me2Bcu,omitempty"`
+	MSESS                *float64 `json:"msess,omitempty"`
+	MSESS_BCL            *float64 `json:"msessBcl,omitempty"`
+	MSESS_BCU            *float64 `json:"msessBcu,omitempty"`
+	RMSFA                *float64 `json:"rmsfa,omitempty"`
+	RMSFA_BCL            *float64 `json:"rmsfaBcl,omitempty"`
+	RMSFA_BCU            *float64 `json:"rmsfaBcu,omitempty"`
+	RMSOA                *float64 `json:"rmsoa,omitempty"`
+	RMSOA_BCL            *float64 `json:"rmsoaBcl,omitempty"`
+	RMSOA_BCU            *float64 `json:"rmsoaBcu,omitempty"`
+	ANOM_CORR_UNCNTR     *float64 `json:"anomCorrUncntr,omitempty"`
+	ANOM_CORR_UNCNTR_BCL *float64 `json:"anomCorrUncntrBcl,omitempty"`
+	ANOM_CORR_UNCNTR_BCU *float64 `json:"anomCorrUncntrBcu,omitempty"`
+	SI                   *float64 `json:"si,omitempty"`
+	SI_BCL               *float64 `json:"siBcl,omitempty"`
+	SI_BCU               *float64 `json:"siBcu,omitempty"`
+	MISSING              []string `json:"missing,omitempty"`
+}
+
+type STAT_CTC struct {
+	TOTAL    *int     `json:"total,omitempty"`
+	FY_OY    *float64 `json:"fyOy,omitempty"`
+	FY_ON    *float64 `json:"fyOn,omitempty"`
+	FN_OY    *float64 `json:"fnOy,omitempty"`
+	FN_ON    *float64 `json:"fnOn,omitempty"`
+	EC_VALUE *float64 `json:"ecValue,omitempty"`
+	MISSING  []string `json:"missing,omitempty"`
+}
+
+type STAT_CTS struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	BASER      *float64 `json:"baser,omitempty"`
+	BASER_NCL  *float64 `json:"baserNcl,omitempty"`
+	BASER_NCU  *float64 `json:"baserNcu,omitempty"`
+	BASER_BCL  *float64 `json:"baserBcl,omitempty"`
+	BASER_BCU  *float64 `json:"baserBcu,omitempty"`
+	FMEAN      *float64 `json:"fmean,omitempty"`
+	FMEAN_NCL  *float64 `json:"fmeanNcl,omitempty"`
+	FMEAN_NCU  *float64 `json:"fmeanNcu,omitempty"`
+	FMEAN_BCL  *float64 `json:"fmeanBcl,omitempty"`
+	FMEAN_BCU  *float64 `json:"fmeanBcu,omitempty"`
+	ACC        *float64 `json:"acc,omitempty"`
+	ACC_NCL    *float64 `json:"accNcl,omitempty"`
+	ACC_NCU    *float64 `json:"accNcu,omitempty"`
+	ACC_BCL    *float64 `json:"accBcl,omitempty"`
+	ACC_BCU    *float64 `json:"accBcu,omitempty"`
+	FBIAS      *float64 `json:"fbias,omitempty"`
+	FBIAS_BCL  *float64 `json:"fbiasBcl,omitempty"`
+	FBIAS_BCU  *float64 `json:"fbiasBcu,omitempty"`
+	PODY       *float64 `json:"pody,omitempty"`
+	PODY_NCL   *float64 `json:"podyNcl,omitempty"`
+	PODY_NCU   *float64 `json:"podyNcu,omitempty"`
+	PODY_BCL   *float64 `json:"podyBcl,omitempty"`
+	PODY_BCU   *float64 `json:"podyBcu,omitempty"`
+	PODN       *float64 `json:"podn,omitempty"`
+	PODN_NCL   *float64 `json:"podnNcl,omitempty"`
+	PODN_NCU   *float64 `json:"podnNcu,omitempty"`
+	PODN_BCL   *float64 `json:"podnBcl,omitempty"`
+	PODN_BCU   *float64 `json:"podnBcu,omitempty"`
+	POFD       *float64 `json:"pofd,omitempty"`
+	POFD_NCL   *float64 `json:"pofdNcl,omitempty"`
+	POFD_NCU   *float64 `json:"pofdNcu,omitempty"`
+	POFD_BCL   *float64 `json:"pofdBcl,omitempty"`
+	POFD_BCU   *float64 `json:"pofdBcu,omitempty"`
+	FAR        *float64 `json:"far,omitempty"`
+	FAR_NCL    *float64 `json:"farNcl,omitempty"`
+	FAR_NCU    *float64 `json:"farNcu,omitempty"`
+	FAR_BCL    *float64 `json:"farBcl,omitempty"`
+	FAR_BCU    *float64 `json:"farBcu,omitempty"`
+	CSI        *float64 `json:"csi,omitempty"`
+	CSI_NCL    *float64 `json:"csiNcl,omitempty"`
+	CSI_NCU    *float64 `json:"csiNcu,omitempty"`
+	CSI_BCL    *float64 `json:"csiBcl,omitempty"`
+	CSI_BCU    *float64 `json:"csiBcu,omitempty"`
+	GSS        *float64 `json:"gss,omitempty"`
+	GSS_BCL    *float64 `json:"gssBcl,omitempty"`
+	GSS_BCU    *float64 `json:"gssBcu,omitempty"`
+	HK         *float64 `json:"hk,omitempty"`
+	HK_NCL     *float64 `json:"hkNcl,omitempty"`
+	HK_NCU     *float64 `json:"hkNcu,omitempty"`
+	HK_BCL     *float64 `json:"hkBcl,omitempty"`
+	HK_BCU     *float64 `json:"hkBcu,omitempty"`
+	HSS        *float64 `json:"hss,omitempty"`
+	HSS_BCL    *float64 `json:"hssBcl,omitempty"`
+	HSS_BCU    *float64 `json:"hssBcu,omitempty"`
+	ODDS       *float64 `json:"odds,omitempty"`
+	ODDS_NCL   *float64 `json:"oddsNcl,omitempty"`
+	ODDS_NCU   *float64 `json:"oddsNcu,omitempty"`
+	ODDS_BCL   *float64 `json:"oddsBcl,omitempty"`
+	ODDS_BCU   *float64 `json:"oddsBcu,omitempty"`
+	LODDS      *float64 `json:"lodds,omitempty"`
+	LODDS_NCL  *float64 `json:"loddsNcl,omitempty"`
+	LODDS_NCU  *float64 `json:"loddsNcu,omitempty"`
+	LODDS_BCL  *float64 `json:"loddsBcl,omitempty"`
+	LODDS_BCU  *float64 `json:"loddsBcu,omitempty"`
+	ORSS       *float64 `json:"orss,omitempty"`
+	ORSS_NCL   *float64 `json:"orssNcl,omitempty"`
+	ORSS_NCU   *float64 `json:"orssNcu,omitempty"`
+	ORSS_BCL   *float64 `json:"orssBcl,omitempty"`
+	ORSS_BCU   *float64 `json:"orssBcu,omitempty"`
+	EDS        *float64 `json:"eds,omitempty"`
+	EDS_NCL    *float64 `json:"edsNcl,omitempty"`
+	EDS_NCU    *float64 `json:"edsNcu,omitempty"`
+	EDS_BCL    *float64 `json:"edsBcl,omitempty"`
+	EDS_BCU    *float64 `json:"edsBcu,omitempty"`
+	SEDS       *float64 `json:"seds,omitempty"`
+	SEDS_NCL   *float64 `json:"sedsNcl,omitempty"`
+	SEDS_NCU   *float64 `json:"sedsNcu,omitempty"`
+	SEDS_BCL   *float64 `json:"sedsBcl,omitempty"`
+	SEDS_BCU   *float64 `json:"sedsBcu,omitempty"`
+	EDI        *float64 `json:"edi,omitempty"`
+	EDI_NCL    *float64 `json:"ediNcl,omitempty"`
+	EDI_NCU    *float64 `json:"ediNcu,omitempty"`
+	EDI_BCL    *float64 `json:"ediBcl,omitempty"`
+	EDI_BCU    *float64 `json:"ediBcu,omitempty"`
+	SEDI       *float64 `json:"sedi,omitempty"`
+	SEDI_NCL   *float64 `json:"sediNcl,omitempty"`
+	SEDI_NCU   *float64 `json:"sediNcu,omitempty"`
+	SEDI_BCL   *float64 `json:"sediBcl,omitempty"`
+	SEDI_BCU   *float64 `json:"sediBcu,omitempty"`
+	BAGSS      *float64 `json:"bagss,omitempty"`
+	BAGSS_BCL  *float64 `json:"bagssBcl,omitempty"`
+	BAGSS_BCU  *float64 `json:"bagssBcu,omitempty"`
+	HSS_EC     *float64 `json:"hssEc,omitempty"`
+	HSS_EC_BCL *float64 `json:"hssEcBcl,omitempty"`
+	HSS_EC_BCU *float64 `json:"hssEcBcu,omitempty"`
+	EC_VALUE   *float64 `json:"ecValue,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_DMAP struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	FY         *int     `json:"fy,omitempty"`
+	OY         *int     `json:"oy,omitempty"`
+	FBIAS      *float64 `json:"fbias,omitempty"`
+	BADDELEY   *float64 `json:"baddeley,omitempty"`
+	HAUSDORFF  *float64 `json:"hausdorff,omitempty"`
+	MED_FO     *float64 `json:"medFo,omitempty"`
+	MED_OF     *float64 `json:"medOf,omitempty"`
+	MED_MIN    *float64 `json:"medMin,omitempty"`
+	MED_MAX    *float64 `json:"medMax,omitempty"`
+	MED_MEAN   *float64 `json:"medMean,omitempty"`
+	FOM_FO     *float64 `json:"fomFo,omitempty"`
+	FOM_OF     *float64 `json:"fomOf,omitempty"`
+	FOM_MIN    *float64 `json:"fomMin,omitempty"`
+	FOM_MAX    *float64 `json:"fomMax,omitempty"`
+	FOM_MEAN   *float64 `json:"fomMean,omitempty"`
+	ZHU_FO     *float64 `json:"zhuFo,omitempty"`
+	ZHU_OF     *float64 `json:"zhuOf,omitempty"`
+	ZHU_MIN    *float64 `json:"zhuMin,omitempty"`
+	ZHU_MAX    *float64 `json:"zhuMax,omitempty"`
+	ZHU_MEAN   *float64 `json:"zhuMean,omitempty"`
+	G          *float64 `json:"g,omitempty"`
+	GBETA      *float64 `json:"gbeta,omitempty"`
+	BETA_VALUE *float64 `json:"betaValue,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_ECLV struct {
+	TOTAL       *int                   `json:"total,omitempty"`
+	BASER       *float64               `json:"baser,omitempty"`
+	VALUE_BASER *int                   `json:"valueBaser,omitempty"`
+	PTS         map[string]interface{} `json:"pts,omitempty"`
+	MISSING     []string               `json:"missing,omitempty"`
+}
+
+type STAT_ECNT struct {
+	TOTAL            *int     `json:"total,omitempty"`
+	N_ENS            *int     `json:"nEns,omitempty"`
+	CRPS             *float64 `json:"crps,omitempty"`
+	CRPSS            *float64 `json:"crpss,omitempty"`
+	IGN              *float64 `json:"ign,omitempty"`
+	ME               *float64 `json:"me,omitempty"`
+	RMSE             *float64 `json:"rmse,omitempty"`
+	SPREAD           *float64 `json:"spread,omitempty"`
+	ME_OERR          *float64 `json:"meOerr,omitempty"`
+	RMSE_OERR        *float64 `json:"rmseOerr,omitempty"`
+	SPREAD_OERR      *float64 `json:"spreadOerr,omitempty"`
+	SPREAD_PLUS_OERR *float64 `json:"spreadPlusOerr,omitempty"`
+	CRPSCL           *float64 `json:"crpscl,omitempty"`
+	CRPS_EMP         *float64 `json:"crpsEmp,omitempty"`
+	CRPSCL_EMP       *float64 `json:"crpsclEmp,omitempty"`
+	CRPSS_EMP        *float64 `json:"crpssEmp,omitempty"`
+	CRPS_EMP_FAIR    *float64 `json:"crpsEmpFair,omitempty"`
+	SPREAD_MD        *float64 `json:"spreadMd,omitempty"`
+	MAE              *float64 `json:"mae,omitempty"`
+	MAE_OERR         *float64 `json:"maeOerr,omitempty"`
+	BIAS_RATIO       *float64 `json:"biasRatio,omitempty"`
+	N_GE_OBS         *int     `json:"nGeObs,omitempty"`
+	ME_GE_OBS        *float64 `json:"meGeObs,omitempty"`
+	N_LT_OBS         *int     `json:"nLtObs,omitempty"`
+	ME_LT_OBS        *float64 `json:"meLtObs,omitempty"`
+	MISSING          []string `json:"missing,omitempty"`
+}
+
+type STAT_FHO struct {
+	TOTAL   *int     `json:"total,omitempty"`
+	F_RATE  *float64 `json:"fRate,omitempty"`
+	H_RATE  *float64 `json:"hRate,omitempty"`
+	O_RATE  *float64 `json:"oRate,omitempty"`
+	MISSING []string `json:"missing,omitempty"`
+}
+
+type STAT_GENMPR struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	INDEX      *int     `json:"index,omitempty"`
+	STORM_ID   string   `json:"stormId,omitempty"`
+	PROB_LEAD  *float64 `json:"probLead,omitempty"`
+	PROB_VAL   *float64 `json:"probVal,omitempty"`
+	AGEN_INIT  string   `json:"agenInit,omitempty"`
+	AGEN_FHR   string   `json:"agenFhr,omitempty"`
+	AGEN_LAT   *float64 `json:"agenLat,omitempty"`
+	AGEN_LON   *float64 `json:"agenLon,omitempty"`
+	AGEN_DLAND *float64 `json:"agenDland,omitempty"`
+	BGEN_LAT   *float64 `json:"bgenLat,omitempty"`
+	BGEN_LON   *float64 `json:"bgenLon,omitempty"`
+	BGEN_DLAND *float64 `json:"bgenDland,omitempty"`
+	GEN_DIST   *float64 `json:"genDist,omitempty"`
+	GEN_TDIFF  string   `json:"genTdiff,omitempty"`
+	INIT_TDIFF string   `json:"initTdiff,omitempty"`
+	DEV_CAT    string   `json:"devCat,omitempty"`
+	OPS_CAT    string   `json:"opsCat,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_GRAD struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	FGBAR      *float64 `json:"fgbar,omitempty"`
+	OGBAR      *float64 `json:"ogbar,omitempty"`
+	MGBAR      *float64 `json:"mgbar,omitempty"`
+	EGBAR      *float64 `json:"egbar,omitempty"`
+	S1         *float64 `json:"s1,omitempty"`
+	S1_OG      *float64 `json:"s1Og,omitempty"`
+	FGOG_RATIO *float64 `json:"fgogRatio,omitempty"`
+	DX         *float64 `json:"dx,omitempty"`
+	DY         *float64 `json:"dy,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_ISC struct {
+	TOTAL    *int     `json:"total,omitempty"`
+	TILE_DIM *int     `json:"tileDim,omitempty"`
+	TILE_XLL *int     `json:"tileXll,omitempty"`
+	TILE_YLL *int     `json:"tileYll,omitempty"`
+	NSCALE   *int     `json:"nscale,omitempty"`
+	ISCALE   *int     `json:"iscale,omitempty"`
+	MSE      *float64 `json:"mse,omitempty"`
+	ISC      *float64 `json:"isc,omitempty"`
+	FENERGY2 *float64 `json:"fenergy2,omitempty"`
+	OENERGY2 *float64 `json:"oenergy2,omitempty"`
+	BASER    *float64 `json:"baser,omitempty"`
+	FBIAS    *float64 `json:"fbias,omitempty"`
+	MISSING  []string `json:"missing,omitempty"`
+}
+
+type STAT_MCTC struct {
+	TOTAL    *int                   `json:"total,omitempty"`
+	CAT      map[string]interface{} `json:"cat,omitempty"`
+	EC_VALUE *float64               `json:"ecValue,omitempty"`
+	MISSING  []string               `json:"missing,omitempty"`
+}
+
+type STAT_MCTS struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	N_CAT      *int     `json:"nCat,omitempty"`
+	ACC        *float64 `json:"acc,omitempty"`
+	ACC_NCL    *float64 `json:"accNcl,omitempty"`
+	ACC_NCU    *float64 `json:"accNcu,omitempty"`
+	ACC_BCL    *float64 `json:"accBcl,omitempty"`
+	ACC_BCU    *float64 `json:"accBcu,omitempty"`
+	HK         *float64 `json:"hk,omitempty"`
+	HK_BCL     *float64 `json:"hkBcl,omitempty"`
+	HK_BCU     *float64 `json:"hkBcu,omitempty"`
+	HSS        *float64 `json:"hss,omitempty"`
+	HSS_BCL    *float64 `json:"hssBcl,omitempty"`
+	HSS_BCU    *float64 `json:"hssBcu,omitempty"`
+	GER        *float64 `json:"ger,omitempty"`
+	GER_BCL    *float64 `json:"gerBcl,omitempty"`
+	GER_BCU    *float64 `json:"gerBcu,omitempty"`
+	HSS_EC     *float64 `json:"hssEc,omitempty"`
+	HSS_EC_BCL *float64 `json:"hssEcBcl,omitempty"`
+	HSS_EC_BCU *float64 `json:"hssEcBcu,omitempty"`
+	EC_VALUE   *float64 `json:"ecValue,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_MPR struct {
+	TOTAL       *int     `json:"total,omitempty"`
+	INDEX       *int     `json:"index,omitempty"`
+	OBS_SID     string   `json:"obsSid,omitempty"`
+	OBS_LAT     *float64 `json:"obsLat,omitempty"`
+	OBS_LON     *float64 `json:"obsLon,omitempty"`
+	OBS_LVL     *float64 `json:"obsLvl,omitempty"`
+	OBS_ELV     *float64 `json:"obsElv,omitempty"`
+	FCST        *float64 `json:"fcst,omitempty"`
+	OBS         *float64 `json:"obs,omitempty"`
+	OBS_QC      string   `json:"obsQc,omitempty"`
+	CLIMO_MEAN  *float64 `json:"climoMean,omitempty"`
+	CLIMO_STDEV *float64 `json:"climoStdev,omitempty"`
+	CLIMO_CDF   *float64 `json:"climoCdf,omitempty"`
+	MISSING     []string `json:"missing,omitempty"`
+}
+
+type STAT_NBRCNT struct {
+	TOTAL      *int     `json:"total,omitempty"`
+	FBS        *float64 `json:"fbs,omitempty"`
+	FBS_BCL    *float64 `json:"fbsBcl,omitempty"`
+	FBS_BCU    *float64 `json:"fbsBcu,omitempty"`
+	FSS        *float64 `json:"fss,omitempty"`
+	FSS_BCL    *float64 `json:"fssBcl,omitempty"`
+	FSS_BCU    *float64 `json:"fssBcu,omitempty"`
+	AFSS       *float64 `json:"afss,omitempty"`
+	AFSS_BCL   *float64 `json:"afssBcl,omitempty"`
+	AFSS_BCU   *float64 `json:"afssBcu,omitempty"`
+	UFSS       *float64 `json:"ufss,omitempty"`
+	UFSS_BCL   *float64 `json:"ufssBcl,omitempty"`
+	UFSS_BCU   *float64 `json:"ufssBcu,omitempty"`
+	F_RATE     *float64 `json:"fRate,omitempty"`
+	F_RATE_BCL *float64 `json:"fRateBcl,omitempty"`
+	F_RATE_BCU *float64 `json:"fRateBcu,omitempty"`
+	O_RATE     *float64 `json:"oRate,omitempty"`
+	O_RATE_BCL *float64 `json:"oRateBcl,omitempty"`
+	O_RATE_BCU *float64 `json:"oRateBcu,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_NBRCTC struct {
+	TOTAL   *int     `json:"total,omitempty"`
+	FY_OY   *float64 `json:"fyOy,omitempty"`
+	FY_ON   *float64 `json:"fyOn,omitempty"`
+	FN_OY   *float64 `json:"fnOy,omitempty"`
+	FN_ON   *float64 `json:"fnOn,omitempty"`
+	MISSING []string `json:"missing,omitempty"`
+}
+
+type STAT_NBRCTS struct {
+	TOTAL     *int     `json:"total,omitempty"`
+	BASER     *float64 `json:"baser,omitempty"`
+	BASER_NCL *float64 `json:"baserNcl,omitempty"`
+	BASER_NCU *float64 `json:"baserNcu,omitempty"`
+	BASER_BCL *float64 `json:"baserBcl,omitempty"`
+	BASER_BCU *float64 `json:"baserBcu,omitempty"`
+	FMEAN     *float64 `json:"fmean,omitempty"`
+	FMEAN_NCL *float64 `json:"fmeanNcl,omitempty"`
+	FMEAN_NCU *float64 `json:"fmeanNcu,omitempty"`
+	FMEAN_BCL *float64 `json:"fmeanBcl,omitempty"`
+	FMEAN_BCU *float64 `json:"fmeanBcu,omitempty"`
+	ACC       *float64 `json:"acc,omitempty"`
+	ACC_NCL   *float64 `json:"accNcl,omitempty"`
+	ACC_NCU   *float64 `json:"accNcu,omitempty"`
+	ACC_BCL   *float64 `json:"accBcl,omitempty"`
+	ACC_BCU   *float64 `json:"accBcu,omitempty"`
+	FBIAS     *float64 `json:"fbias,omitempty"`
+	FBIAS_BCL *float64 `json:"fbiasBcl,omitempty"`
+	FBIAS_BCU *float64 `json:"fbiasBcu,omitempty"`
+	PODY      *float64 `json:"pody,omitempty"`
+	PODY_NCL  *float64 `json:"podyNcl,omitempty"`
+	PODY_NCU  *float64 `json:"podyNcu,omitempty"`
+	PODY_BCL  *float64 `json:"podyBcl,omitempty"`
+	PODY_BCU  *float64 `json:"podyBcu,omitempty"`
+	PODN      *float64 `json:"podn,omitempty"`
+	PODN_NCL  *float64 `json:"podnNcl,omitempty"`
+	PODN_NCU  *float64 `json:"podnNcu,omitempty"`
+	PODN_BCL  *float64 `json:"podnBcl,omitempty"`
+	PODN_BCU  *float64 `json:"podnBcu,omitempty"`
+	POFD      *float64 `json:"pofd,omitempty"`
+	POFD_NCL  *float64 `json:"pofdNcl,omitempty"`
+	POFD_NCU  *float64 `json:"pofdNcu,omitempty"`
+	POFD_BCL  *float64 `json:"pofdBcl,omitempty"`
+	POFD_BCU  *float64 `json:"pofdBcu,omitempty"`
+	FAR       *float64 `json:"far,omitempty"`
+	FAR_NCL   *float64 `json:"farNcl,omitempty"`
+	FAR_NCU   *float64 `json:"farNcu,omitempty"`
+	FAR_BCL   *float64 `json:"farBcl,omitempty"`
+	FAR_BCU   *float64 `json:"farBcu,omitempty"`
+	CSI       *float64 `json:"csi,omitempty"`
+	CSI_NCL   *float64 `json:"csiNcl,omitempty"`
+	CSI_NCU   *float64 `json:"csiNcu,omitempty"`
+	CSI_BCL   *float64 `json:"csiBcl,omitempty"`
+	CSI_BCU   *float64 `json:"csiBcu,omitempty"`
+	GSS       *float64 `json:"gss,omitempty"`
+	GSS_BCL   *float64 `json:"gssBcl,omitempty"`
+	GSS_BCU   *float64 `json:"gssBcu,omitempty"`
+	HK        *float64 `json:"hk,omitempty"`
+	HK_NCL    *float64 `json:"hkNcl,omitempty"`
+	HK_NCU    *float64 `json:"hkNcu,omitempty"`
+	HK_BCL    *float64 `json:"hkBcl,omitempty"`
+	HK_BCU    *float64 `json:"hkBcu,omitempty"`
+	HSS       *float64 `json:"hss,omitempty"`
+	HSS_BCL   *float64 `json:"hssBcl,omitempty"`
+	HSS_BCU   *float64 `json:"hssBcu,omitempty"`
+	ODDS      *float64 `json:"odds,omitempty"`
+	ODDS_NCL  *float64 `json:"oddsNcl,omitempty"`
+	ODDS_NCU  *float64 `json:"oddsNcu,omitempty"`
+	ODDS_BCL  *float64 `json:"oddsBcl,omitempty"`
+	ODDS_BCU  *float64 `json:"oddsBcu,omitempty"`
+	LODDS     *float64 `json:"lodds,omitempty"`
+	LODDS_NCL *float64 `json:"loddsNcl,omitempty"`
+	LODDS_NCU *float64 `json:"loddsNcu,omitempty"`
+	LODDS_BCL *float64 `json:"loddsBcl,omitempty"`
+	LODDS_BCU *float64 `json:"loddsBcu,omitempty"`
+	ORSS      *float64 `json:"orss,omitempty"`
+	ORSS_NCL  *float64 `json:"orssNcl,omitempty"`
+	ORSS_NCU  *float64 `json:"orssNcu,omitempty"`
+	ORSS_BCL  *float64 `json:"orssBcl,omitempty"`
+	ORSS_BCU  *float64 `json:"orssBcu,omitempty"`
+	EDS       *float64 `json:"eds,omitempty"`
+	EDS_NCL   *float64 `json:"edsNcl,omitempty"`
+	EDS_NCU   *float64 `json:"edsNcu,omitempty"`
+	EDS_BCL   *float64 `json:"edsBcl,omitempty"`
+	EDS_BCU   *float64 `json:"edsBcu,omitempty"`
+	SEDS      *float64 `json:"seds,omitempty"`
+	SEDS_NCL  *float64 `json:"sedsNcl,omitempty"`
+	SEDS_NCU  *float64 `json:"sedsNcu,omitempty"`
+	SEDS_BCL  *float64 `json:"sedsBcl,omitempty"`
+	SEDS_BCU  *float64 `json:"sedsBcu,omitempty"`
+	EDI       *float64 `json:"edi,omitempty"`
+	EDI_NCL   *float64 `json:"ediNcl,omitempty"`
+	EDI_NCU   *float64 `json:"ediNcu,omitempty"`
+	EDI_BCL   *float64 `json:"ediBcl,omitempty"`
+	EDI_BCU   *float64 `json:"ediBcu,omitempty"`
+	SEDI      *float64 `json:"sedi,omitempty"`
+	SEDI_NCL  *float64 `json:"sediNcl,omitempty"`
+	SEDI_NCU  *float64 `json:"sediNcu,omitempty"`
+	SEDI_BCL  *float64 `json:"sediBcl,omitempty"`
+	SEDI_BCU  *float64 `json:"sediBcu,omitempty"`
+	BAGSS     *float64 `json:"bagss,omitempty"`
+	BAGSS_BCL *float64 `json:"bagssBcl,omitempty"`
+	BAGSS_BCU *float64 `json:"bagssBcu,omitempty"`
+	MISSING   []string `json:"missing,omitempty"`
+}
+
+type STAT_ORANK struct {
+	TOTAL            *int                   `json:"total,omitempty"`
+	INDEX            *int                   `json:"index,omitempty"`
+	OBS_SID          string                 `json:"obsSid,omitempty"`
+	OBS_LAT          *float64               `json:"obsLat,omitempty"`
+	OBS_LON          *float64               `json:"obsLon,omitempty"`
+	OBS_LVL          *float64               `json:"obsLvl,omitempty"`
+	OBS_ELV          *float64               `json:"obsElv,omitempty"`
+	OBS              *float64               `json:"obs,omitempty"`
+	PIT              *float64               `json:"pit,omitempty"`
+	RANK             *int                   `json:"rank,omitempty"`
+	N_ENS_VLD        *int                   `json:"nEnsVld,omitempty"`
+	ENS              map[string]interface{} `json:"ens,omitempty"`
+	OBS_QC           string                 `json:"obsQc,omitempty"`
+	ENS_MEAN         *int                   `json:"ensMean,omitempty"`
+	CLIMO_MEAN       *float64               `json:"climoMean,omitempty"`
+	SPREAD           *float64               `json:"spread,omitempty"`
+	ENS_MEAN_OERR    *int                   `json:"ensMeanOerr,omitempty"`
+	SPREAD_OERR      *float64               `json:"spreadOerr,omitempty"`
+	SPREAD_PLUS_OERR *float64               `json:"spreadPlusOerr,omitempty"`
+	CLIMO_STDEV      *float64               `json:"climoStdev,omitempty"`
+	MISSING          []string               `json:"missing,omitempty"`
+}
+
+type STAT_PCT struct {
+	TOTAL   *int                   `json:"total,omitempty"`
+	THRESH  map[string]interface{} `json:"thresh,omitempty"`
+	MISSING []string               `json:"missing,omitempty"`
+}
+
+type STAT_PHIST struct {
+	TOTAL    *int                   `json:"total,omitempty"`
+	BIN_SIZE *int                   `json:"binSize,omitempty"`
+	BIN      map[string]interface{} `json:"bin,omitempty"`
+	MISSING  []string               `json:"missing,omitempty"`
+}
+
+type STAT_PJC struct {
+	TOTAL   *int                   `json:"total,omitempty"`
+	THRESH  map[string]interface{} `json:"thresh,omitempty"`
+	MISSING []string               `json:"missing,omitempty"`
+}
+
+type STAT_PRC struct {
+	TOTAL   *int                   `json:"total,omitempty"`
+	THRESH  map[string]interface{} `json:"thresh,omitempty"`
+	MISSING []string               `json:"missing,omitempty"`
+}
+
+type STAT_PSTD struct {
+	TOTAL       *int                   `json:"total,omitempty"`
+	THRESH      map[string]interface{} `json:"thresh,omitempty"`
+	BASER_NCL   *float64               `json:"baserNcl,omitempty"`
+	BASER_NCU   *float64               `json:"baserNcu,omitempty"`
+	RELIABILITY *float64               `json:"reliability,omitempty"`
+	RESOLUTION  *float64               `json:"resolution,omitempty"`
+	UNCERTAINTY *float64               `json:"uncertainty,omitempty"`
+	ROC_AUC     *float64               `json:"rocAuc,omitempty"`
+	BRIER       *float64               `json:"brier,omitempty"`
+	BRIER_NCL   *float64               `json:"brierNcl,omitempty"`
+	BRIER_NCU   *float64               `json:"brierNcu,omitempty"`
+	BRIERCL     *float64               `json:"briercl,omitempty"`
+	BRIERCL_NCL *float64               `json:"brierclNcl,omitempty"`
+	BRIERCL_NCU *float64               `json:"brierclNcu,omitempty"`
+	BSS         *float64               `json:"bss,omitempty"`
+	BSS_SMPL    *float64               `json:"bssSmpl,omitempty"`
+	THRESH_I    *int                   `json:"threshI,omitempty"`
+	MISSING     []string               `json:"missing,omitempty"`
+}
+
+type STAT_RELP struct {
+	TOTAL   *int                   `json:"total,omitempty"`
+	ENS     map[string]interface{} `json:"ens,omitempty"`
+	MISSING []string               `json:"missing,omitempty"`
+}
+
+type STAT_RHIST struct {
+	TOTAL   *int                   `json:"total,omitempty"`
+	RANK    map[string]interface{} `json:"rank,omitempty"`
+	MISSING []string               `json:"missing,omitempty"`
+}
+
+type STAT_RPS struct {
+	TOTAL     *int     `json:"total,omitempty"`
+	N_PROB    *int     `json:"nProb,omitempty"`
+	RPS_REL   *float64 `json:"rpsRel,omitempty"`
+	RPS_RES   *float64 `json:"rpsRes,omitempty"`
+	RPS_UNC   *float64 `json:"rpsUnc,omitempty"`
+	RPS       *float64 `json:"rps,omitempty"`
+	RPSS      *float64 `json:"rpss,omitempty"`
+	RPSS_SMPL *float64 `json:"rpssSmpl,omitempty"`
+	RPS_COMP  *float64 `json:"rpsComp,omitempty"`
+	MISSING   []string `json:"missing,omitempty"`
+}
+
+type STAT_SAL1L2 struct {
+	TOTAL   *int     `json:"total,omitempty"`
+	FABAR   *float64 `json:"fabar,omitempty"`
+	OABAR   *float64 `json:"oabar,omitempty"`
+	FOABAR  *float64 `json:"foabar,omitempty"`
+	FFABAR  *float64 `json:"ffabar,omitempty"`
+	OOABAR  *float64 `json:"ooabar,omitempty"`
+	MAE     *float64 `json:"mae,omitempty"`
+	MISSING []string `json:"missing,omitempty"`
+}
+
+type STAT_SEEPS struct {
+	TOTAL     *int     `json:"total,omitempty"`
+	S12       string   `json:"s12,omitempty"`
+	S13       string   `json:"s13,omitempty"`
+	S21       string   `json:"s21,omitempty"`
+	S23       string   `json:"s23,omitempty"`
+	S31       string   `json:"s31,omitempty"`
+	S32       string   `json:"s32,omitempty"`
+	PF1       *float64 `json:"pf1,omitempty"`
+	PF2       *float64 `json:"pf2,omitempty"`
+	PF3       *float64 `json:"pf3,omitempty"`
+	PV1       *float64 `json:"pv1,omitempty"`
+	PV2       *float64 `json:"pv2,omitempty"`
+	PV3       *float64 `json:"pv3,omitempty"`
+	MEAN_FCST *float64 `json:"meanFcst,omitempty"`
+	MEAN_OBS  *float64 `json:"meanObs,omitempty"`
+	SEEPS     *float64 `json:"seeps,omitempty"`
+	MISSING   []string `json:"missing,omitempty"`
+}
+
+type STAT_SEEPS_MPR struct {
+	OBS_SID  string   `json:"obsSid,omitempty"`
+	OBS_LAT  *float64 `json:"obsLat,omitempty"`
+	OBS_LON  *float64 `json:"obsLon,omitempty"`
+	FCST     *float64 `json:"fcst,omitempty"`
+	OBS      *float64 `json:"obs,omitempty"`
+	OBS_QC   string   `json:"obsQc,omitempty"`
+	FCST_CAT *int     `json:"fcstCat,omitempty"`
+	OBS_CAT  *int     `json:"obsCat,omitempty"`
+	P1       *float64 `json:"p1,omitempty"`
+	P2       *float64 `json:"p2,omitempty"`
+	T1       *float64 `json:"t1,omitempty"`
+	T2       *float64 `json:"t2,omitempty"`
+	SEEPS    *float64 `json:"seeps,omitempty"`
+	MISSING  []string `json:"missing,omitempty"`
+}
+
+type STAT_SL1L2 struct {
+	TOTAL   *int     `json:"total,omitempty"`
+	FBAR    *float64 `json:"fbar,omitempty"`
+	OBAR    *float64 `json:"obar,omitempty"`
+	FOBAR   *float64 `json:"fobar,omitempty"`
+	FFBAR   *float64 `json:"ffbar,omitempty"`
+	OOBAR   *float64 `json:"oobar,omitempty"`
+	MAE     *float64 `json:"mae,omitempty"`
+	MISSING []string `json:"missing,omitempty"`
+}
+
+type STAT_SSIDX struct {
+	FCST_MODEL string   `json:"fcstModel,omitempty"`
+	REF_MODEL  string   `json:"refModel,omitempty"`
+	N_INIT     *int     `json:"nInit,omitempty"`
+	N_TERM     *int     `json:"nTerm,omitempty"`
+	N_VLD      *int     `json:"nVld,omitempty"`
+	SS_INDEX   *float64 `json:"ssIndex,omitempty"`
+	MISSING    []string `json:"missing,omitempty"`
+}
+
+type STAT_SSVAR struct {
+	TOTAL       *int     `json:"total,omitempty"`
+	N_BIN       *int     `json:"nBin,omitempty"`
+	BIN_I       *int     `json:"binI,omitempty"`
+	BIN_N       *int     `json:"binN,omitempty"`
+	VAR_MIN     *float64 `json:"varMin,omitempty"`
+	VAR_MAX     *float64 `json:"varMax,omitempty"`
+	VAR_MEAN    *float64 `json:"varMean,omitempty"`
+	FBAR        *float64 `json:"fbar,omitempty"`
+	OBAR        *float64 `json:"obar,omitempty"`
+	FOBAR       *float64 `json:"fobar,omitempty"`
+	FFBAR       *float64 `json:"ffbar,omitempty"`
+	OOBAR       *float64 `json:"oobar,omitempty"`
+	FBAR_NCL    *float64 `json:"fbarNcl,omitempty"`
+	FBAR_NCU    *float64 `json:"fbarNcu,omitempty"`
+	FSTDEV      *float64 `json:"fstdev,omitempty"`
+	FSTDEV_NCL  *float64 `json:"fstdevNcl,omitempty"`
+	FSTDEV_NCU  *float64 `json:"fstdevNcu,omitempty"`
+	OBAR_NCL    *float64 `json:"obarNcl,omitempty"`
+	OBAR_NCU    *float64 `json:"obarNcu,omitempty"`
+	OSTDEV      *float64 `json:"ostdev,omitempty"`
+	OSTDEV_NCL  *float64 `json:"ostdevNcl,omitempty"`
+	OSTDEV_NCU  *float64 `json:"ostdevNcu,omitempty"`
+	PR_CORR     *float64 `json:"prCorr,omitempty"`
+	PR_CORR_NCL *float64 `json:"prCorrNcl,omitempty"`
+	PR_CORR_NCU *float64 `json:"prCorrNcu,omitempty"`
+	ME          *float64 `json:"me,omitempty"`
+	ME_NCL      *float64 `json:"meNcl,omitempty"`
+	ME_NCU      *float64 `json:"meNcu,omitempty"`
+	ESTDEV      *float64 `json:"estdev,omitempty"`
+	ESTDEV_NCL  *float64 `json:"estdevNcl,omitempty"`
+	ESTDEV_NCU  *float64 `json:"estdevNcu,omitempty"`
+	MBIAS       *float64 `json:"mbias,omitempty"`
+	MSE         *float64 `json:"mse,omitempty"`
+	BCMSE       *float64 `json:"bcmse,omitempty"`
+	RMSE        *float64 `json:"rmse,omitempty"`
+	MISSING     []string `json:"missing,omitempty"`
+}
+
+type STAT_VAL1L2 struct {
+	TOTAL        *int     `json:"total,omitempty"`
+	UFABAR       *float64 `json:"ufabar,omitempty"`
+	VFABAR       *float64 `json:"vfabar,omitempty"`
+	UOABAR       *float64 `json:"uoabar,omitempty"`
+	VOABAR       *float64 `json:"voabar,omitempty"`
+	UVFOABAR     *float64 `json:"uvfoabar,omitempty"`
+	UVFFABAR     *float64 `json:"uvffabar,omitempty"`
+	UVOOABAR     *float64 `json:"uvooabar,omitempty"`
+	FA_SPEED_BAR *float64 `json:"faSpeedBar,omitempty"`
+	OA_SPEED_BAR *float64 `json:"oaSpeedBar,omitempty"`
+	MISSING      []string `json:"missing,omitempty"`
+}
+
+type STAT_VCNT struct {
+	TOTAL                *int     `json:"total,omitempty"`
+	FBAR                 *float64 `json:"fbar,omitempty"`
+	FBAR_BCL             *float64 `json:"fbarBcl,omitempty"`
+	FBAR_BCU             *float64 `json:"fbarBcu,omitempty"`
+	OBAR                 *float64 `json:"obar,omitempty"`
+	OBAR_BCL             *float64 `json:"obarBcl,omitempty"`
+	OBAR_BCU             *float64 `json:"obarBcu,omitempty"`
+	FS_RMS               *float64 `json:"fsRms,omitempty"`
+	FS_RMS_BCL           *float64 `json:"fsRmsBcl,omitempty"`
+	FS_RMS_BCU           *float64 `json:"fsRmsBcu,omitempty"`
+	OS_RMS               *float64 `json:"osRms,omitempty"`
+	OS_RMS_BCL           *float64 `json:"osRmsBcl,omitempty"`
+	OS_RMS_BCU           *float64 `json:"osRmsBcu,omitempty"`
+	MSVE                 *float64 `json:"msve,omitempty"`
+	MSVE_BCL             *float64 `json:"msveBcl,omitempty"`
+	MSVE_BCU             *float64 `json:"msveBcu,omitempty"`
+	RMSVE                *float64 `json:"rmsve,omitempty"`
+	RMSVE_BCL            *float64 `json:"rmsveBcl,omitempty"`
+	RMSVE_BCU            *float64 `json:"rmsveBcu,omitempty"`
+	FSTDEV               *float64 `json:"fstdev,omitempty"`
+	FSTDEV_BCL           *float64 `json:"fstdevBcl,omitempty"`
+	FSTDEV_BCU           *float64 `json:"fstdevBcu,omitempty"`
+	OSTDEV               *float64 `json:"ostdev,omitempty"`
+	OSTDEV_BCL           *float64 `json:"ostdevBcl,omitempty"`
+	OSTDEV_BCU           *float64 `json:"ostdevBcu,omitempty"`
+	FDIR                 *float64 `json:"fdir,omitempty"`
+	FDIR_BCL             *float64 `json:"fdirBcl,omitempty"`
+	FDIR_BCU             *float64 `json:"fdirBcu,omitempty"`
+	ODIR                 *float64 `json:"odir,omitempty"`
+	ODIR_BCL             *float64 `json:"odirBcl,omitempty"`
+	ODIR_BCU             *float64 `json:"odirBcu,omitempty"`
+	FBAR_SPEED           *float64 `json:"fbarSpeed,omitempty"`
+	FBAR_SPEED_BCL       *float64 `json:"fbarSpeedBcl,omitempty"`
+	FBAR_SPEED_BCU       *float64 `json:"fbarSpeedBcu,omitempty"`
+	OBAR_SPEED           *float64 `json:"obarSpeed,omitempty"`
+	OBAR_SPEED_BCL       *float64 `json:"obarSpeedBcl,omitempty"`
+	OBAR_SPEED_BCU       *float64 `json:"obarSpeedBcu,omitempty"`
+	VDIFF_SPEED          *float64 `json:"vdiffSpeed,omitempty"`
+	VDIFF_SPEED_BCL      *float64 `json:"vdiffSpeedBcl,omitempty"`
+	VDIFF_SPEED_BCU      *float64 `json:"vdiffSpeedBcu,omitempty"`
+	VDIFF_DIR            *float64 `json:"vdiffDir,omitempty"`
+	VDIFF_DIR_BCL        *float64 `json:"vdiffDirBcl,omitempty"`
+	VDIFF_DIR_BCU        *float64 `json:"vdiffDirBcu,omitempty"`
+	SPEED_ERR            *float64 `json:"speedErr,omitempty"`
+	SPEED_ERR_BCL        *float64 `json:"speedErrBcl,omitempty"`
+	SPEED_ERR_BCU        *float64 `json:"speedErrBcu,omitempty"`
+	SPEED_ABSERR         *float64 `json:"speedAbserr,omitempty"`
+	SPEED_ABSERR_BCL     *float64 `json:"speedAbserrBcl,omitempty"`
+	SPEED_ABSERR_BCU     *float64 `json:"speedAbserrBcu,omitempty"`
+	DIR_ERR              *float64 `json:"dirErr,omitempty"`
+	DIR_ERR_BCL          *float64 `json:"dirErrBcl,omitempty"`
+	DIR_ERR_BCU          *float64 `json:"dirErrBcu,omitempty"`
+	DIR_ABSERR           *float64 `json:"dirAbserr,omitempty"`
+	DIR_ABSERR_BCL       *float64 `json:"dirAbserrBcl,omitempty"`
+	DIR_ABSERR_BCU       *float64 `json:"dirAbserrBcu,omitempty"`
+	ANOM_CORR            *float64 `json:"anomCorr,omitempty"`
+	ANOM_CORR_NCL        *float64 `json:"anomCorrNcl,omitempty"`
+	ANOM_CORR_NCU        *float64 `json:"anomCorrNcu,omitempty"`
+	ANOM_CORR_BCL        *float64 `json:"anomCorrBcl,omitempty"`
+	ANOM_CORR_BCU        *float64 `json:"anomCorrBcu,omitempty"`
+	ANOM_CORR_UNCNTR     *float64 `json:"anomCorrUncntr,omitempty"`
+	ANOM_CORR_UNCNTR_BCL *float64 `json:"anomCorrUncntrBcl,omitempty"`
+	ANOM_CORR_UNCNTR_BCU *float64 `json:"anomCorrUncntrBcu,omitempty"`
+	MISSING              []string `json:"missing,omitempty"`
+}
+
+type STAT_VL1L2 struct {
+	TOTAL       *int     `json:"total,omitempty"`
+	UFBAR       *float64 `json:"ufbar,omitempty"`
+	VFBAR       *float64 `json:"vfbar,omitempty"`
+	UOBAR       *float64 `json:"uobar,omitempty"`
+	VOBAR       *float64 `json:"vobar,omitempty"`
+	UVFOBAR     *float64 `json:"uvfobar,omitempty"`
+	UVFFBAR     *float64 `json:"uvffbar,omitempty"`
+	UVOOBAR     *float64 `json:"uvoobar,omitempty"`
+	F_SPEED_BAR *float64 `json:"fSpeedBar,omitempty"`
+	O_SPEED_BAR *float64 `json:"oSpeedBar,omitempty"`
+	MISSING     []string `json:"missing,omitempty"`
+}
+
+type TCST_PROBRIRW struct {
+	ALAT        *float64               `json:"alat,omitempty"`
+	ALON        *float64               `json:"alon,omitempty"`
+	BLAT        *float64               `json:"blat,omitempty"`
+	BLON        *float64               `json:"blon,omitempty"`
+	INITIALS    string                 `json:"initials,omitempty"`
+	TK_ERR      *float64               `json:"tkErr,omitempty"`
+	X_ERR       *float64               `json:"xErr,omitempty"`
+	Y_ERR       *float64               `json:"yErr,omitempty"`
+	ADLAND      *float64               `json:"adland,omitempty"`
+	BDLAND      *float64               `json:"bdland,omitempty"`
+	RIRW_BEG    *int                   `json:"rirwBeg,omitempty"`
+	RIRW_END    *int                   `json:"rirwEnd,omitempty"`
+	RIRW_WINDOW *int                   `json:"rirwWindow,omitempty"`
+	AWIND_END   *float64               `json:"awindEnd,omitempty"`
+	BWIND_BEG   *float64               `json:"bwindBeg,omitempty"`
+	BWIND_END   *float64               `json:"bwindEnd,omitempty"`
+	BDELTA      *float64               `json:"bdelta,omitempty"`
+	BDELTA_MAX  *float64               `json:"bdeltaMax,omitempty"`
+	BLEVEL_BEG  string                 `json:"blevelBeg,omitempty"`
+	BLEVEL_END  string                 `json:"blevelEnd,omitempty"`
+	THRESH      map[string]interface{} `json:"thresh,omitempty"`
+	INIT        *int                   `json:"init,omitempty"`
+	MISSING     []string               `json:"missing,omitempty"`
+}
+
+type TCST_TCDIAG struct {
+	TOTAL        *int                   `json:"total,omitempty"`
+	INDEX        *int                   `json:"index,omitempty"`
+	DIAG_SOURCE  *float64               `json:"diagSource,omitempty"`
+	TRACK_SOURCE string                 `json:"trackSource,omitempty"`
+	FIELD_SOURCE string                 `json:"fieldSource,omitempty"`
+	DIAG         map[string]interface{} `json:"diag,omitempty"`
+	INIT         *int                   `json:"init,omitempty"`
+	MISSING      []string               `json:"missing,omitempty"`
+}
+
+type TCST_TCMPR struct {
+	TOTAL          *int     `json:"total,omitempty"`
+	INDEX          *int     `json:"index,omitempty"`
+	LEVEL          string   `json:"level,omitempty"`
+	WATCH_WARN     string   `json:"watchWarn,omitempty"`
+	INITIALS       string   `json:"initials,omitempty"`
+	ALAT           *float64 `json:"alat,omitempty"`
+	ALON           *float64 `json:"alon,omitempty"`
+	BLAT           *float64 `json:"blat,omitempty"`
+	BLON           *float64 `json:"blon,omitempty"`
+	TK_ERR         *float64 `json:"tkErr,omitempty"`
+	X_ERR          *float64 `json:"xErr,omitempty"`
+	Y_ERR          *float64 `json:"yErr,omitempty"`
+	ALTK_ERR       *float64 `json:"altkErr,omitempty"`
+	CRTK_ERR       *float64 `json:"crtkErr,omitempty"`
+	ADLAND         *float64 `json:"adland,omitempty"`
+	BDLAND         *float64 `json:"bdland,omitempty"`
+	AMSLP          *float64 `json:"amslp,omitempty"`
+	BMSLP          *float64 `json:"bmslp,omitempty"`
+	AMAX_WIND      *float64 `json:"amaxWind,omitempty"`
+	BMAX_WIND      *float64 `json:"bmaxWind,omitempty"`
+	AAL_WIND_34    *float64 `json:"aalWind34,omitempty"`
+	BAL_WIND_34    *float64 `json:"balWind34,omitempty"`
+	ANE_WIND_34    *float64 `json:"aneWind34,omitempty"`
+	BNE_WIND_34    *float64 `json:"bneWind34,omitempty"`
+	ASE_WIND_34    *float64 `json:"aseWind34,omitempty"`
+	BSE_WIND_34    *float64 `json:"bseWind34,omitempty"`
+	ASW_WIND_34    *float64 `json:"aswWind34,omitempty"`
+	BSW_WIND_34    *float64 `json:"bswWind34,omitempty"`
+	ANW_WIND_34    *float64 `json:"anwWind34,omitempty"`
+	BNW_WIND_34    *float64 `json:"bnwWind34,omitempty"`
+	AAL_WIND_50    *float64 `json:"aalWind50,omitempty"`
+	BAL_WIND_50    *float64 `json:"balWind50,omitempty"`
+	ANE_WIND_50    *float64 `json:"aneWind50,omitempty"`
+	BNE_WIND_50    *float64 `json:"bneWind50,omitempty"`
+	ASE_WIND_50    *float64 `json:"aseWind50,omitempty"`
+	BSE_WIND_50    *float64 `json:"bseWind50,omitempty"`
+	ASW_WIND_50    *float64 `json:"aswWind50,omitempty"`
+	BSW_WIND_50    *float64 `json:"bswWind50,omitempty"`
+	ANW_WIND_50    *float64 `json:"anwWind50,omitempty"`
+	BNW_WIND_50    *float64 `json:"bnwWind50,omitempty"`
+	AAL_WIND_64    *float64 `json:"aalWind64,omitempty"`
+	BAL_WIND_64    *float64 `json:"balWind64,omitempty"`
+	ANE_WIND_64    *float64 `json:"aneWind64,omitempty"`
+	BNE_WIND_64    *float64 `json:"bneWind64,omitempty"`
+	ASE_WIND_64    *float64 `json:"aseWind64,omitempty"`
+	BSE_WIND_64    *float64 `json:"bseWind64,omitempty"`
+	ASW_WIND_64    *float64 `json:"aswWind64,omitempty"`
+	BSW_WIND_64    *float64 `json:"bswWind64,omitempty"`
+	ANW_WIND_64    *float64 `json:"anwWind64,omitempty"`
+	BNW_WIND_64    *float64 `json:"bnwWind64,omitempty"`
+	ARADP          string   `json:"aradp,omitempty"`
+	BRADP          *float64 `json:"bradp,omitempty"`
+	ARRP           *int     `json:"arrp,omitempty"`
+	BRRP           *float64 `json:"brrp,omitempty"`
+	AMRD           *int     `json:"amrd,omitempty"`
+	BMRD           *float64 `json:"bmrd,omitempty"`
+	AGUSTS         *int     `json:"agusts,omitempty"`
+	BGUSTS         *float64 `json:"bgusts,omitempty"`
+	AEYE           *int     `json:"aeye,omitempty"`
+	BEYE           *float64 `json:"beye,omitempty"`
+	ADIR           *int     `json:"adir,omitempty"`
+	BDIR           *float64 `json:"bdir,omitempty"`
+	ASPEED         *int     `json:"aspeed,omitempty"`
+	BSPEED         *float64 `json:"bspeed,omitempty"`
+	ADEPTH         *int     `json:"adepth,omitempty"`
+	BDEPTH         *float64 `json:"bdepth,omitempty"`
+	NUM_MEMBERS    *float64 `json:"numMembers,omitempty"`
+	TRACK_SPREAD   *float64 `json:"trackSpread,omitempty"`
+	TRACK_STDEV    *float64 `json:"trackStdev,omitempty"`
+	MSLP_STDEV     *float64 `json:"mslpStdev,omitempty"`
+	MAX_WIND_STDEV *float64 `json:"maxWindStdev,omitempty"`
+	INIT           *int     `json:"init,omitempty"`
+	MISSING        []string `json:"missing,omitempty"`
+}
+
+// fillStructure functions
+func (s *MODE_CTS) fill_MODE_CTS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		if fields[0] != "NA" {
+			s.FIELD = fields[0]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_OY = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_ON = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_OY = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_ON = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.HK = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS = ParseFloatField(fields[18])
+	}
+}
+
+func (s *MODE_OBJ) fill_MODE_OBJ(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		if fields[0] != "NA" {
+			s.OBJECT_ID = fields[0]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[1] != "NA" {
+			s.OBJECT_CAT = fields[1]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.CENTROID_X = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.CENTROID_Y = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.CENTROID_LAT = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.CENTROID_LON = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.AXIS_ANG = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.LENGTH = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.WIDTH = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.AREA = ParseIntField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.AREA_THRESH = ParseIntField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.CURVATURE = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.CURVATURE_X = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.CURVATURE_Y = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.COMPLEXITY = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_10 = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_25 = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_50 = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_75 = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_90 = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_USER = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.INTENSITY_SUM = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.CENTROID_DIST = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.BOUNDARY_DIST = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.CONVEX_HULL_DIST = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.ANGLE_DIFF = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.ASPECT_DIFF = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.AREA_RATIO = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.INTERSECTION_AREA = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.UNION_AREA = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.SYMMETRIC_DIFF = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.INTERSECTION_OVER_AREA = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.CURVATURE_RATIO = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.COMPLEXITY_RATIO = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.PERCENTILE_INTENSITY_RATIO = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.INTEREST = ParseFloatField(fields[35])
+	}
+}
+
+func (s *STAT_CNT) fill_STAT_CNT(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_NCL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_NCU = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_BCL = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_BCU = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_NCL = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_NCU = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_BCL = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_BCU = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_NCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_NCU = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_NCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_NCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_BCL = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_BCU = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_NCL = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_NCU = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_BCL = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_BCU = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.SP_CORR = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.KT_CORR = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.RANKS = ParseIntField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.FRANK_TIES = ParseIntField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.ORANK_TIES = ParseIntField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.ME = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_NCL = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_NCU = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_BCL = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_BCU = ParseFloatField(fields[35])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV = ParseFloatField(fields[36])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_NCL = ParseFloatField(fields[37])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_NCU = ParseFloatField(fields[38])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_BCL = ParseFloatField(fields[39])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_BCU = ParseFloatField(fields[40])
+	}
+	i++
+	if i <= dataLen {
+		s.MBIAS = ParseFloatField(fields[41])
+	}
+	i++
+	if i <= dataLen {
+		s.MBIAS_BCL = ParseFloatField(fields[42])
+	}
+	i++
+	if i <= dataLen {
+		s.MBIAS_BCU = ParseFloatField(fields[43])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE = ParseFloatField(fields[44])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE_BCL = ParseFloatField(fields[45])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE_BCU = ParseFloatField(fields[46])
+	}
+	i++
+	if i <= dataLen {
+		s.MSE = ParseFloatField(fields[47])
+	}
+	i++
+	if i <= dataLen {
+		s.MSE_BCL = ParseFloatField(fields[48])
+	}
+	i++
+	if i <= dataLen {
+		s.MSE_BCU = ParseFloatField(fields[49])
+	}
+	i++
+	if i <= dataLen {
+		s.BCMSE = ParseFloatField(fields[50])
+	}
+	i++
+	if i <= dataLen {
+		s.BCMSE_BCL = ParseFloatField(fields[51])
+	}
+	i++
+	if i <= dataLen {
+		s.BCMSE_BCU = ParseFloatField(fields[52])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE = ParseFloatField(fields[53])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE_BCL = ParseFloatField(fields[54])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE_BCU = ParseFloatField(fields[55])
+	}
+	i++
+	if i <= dataLen {
+		s.E10 = ParseFloatField(fields[56])
+	}
+	i++
+	if i <= dataLen {
+		s.E10_BCL = ParseFloatField(fields[57])
+	}
+	i++
+	if i <= dataLen {
+		s.E10_BCU = ParseFloatField(fields[58])
+	}
+	i++
+	if i <= dataLen {
+		s.E25 = ParseFloatField(fields[59])
+	}
+	i++
+	if i <= dataLen {
+		s.E25_BCL = ParseFloatField(fields[60])
+	}
+	i++
+	if i <= dataLen {
+		s.E25_BCU = ParseFloatField(fields[61])
+	}
+	i++
+	if i <= dataLen {
+		s.E50 = ParseFloatField(fields[62])
+	}
+	i++
+	if i <= dataLen {
+		s.E50_BCL = ParseFloatField(fields[63])
+	}
+	i++
+	if i <= dataLen {
+		s.E50_BCU = ParseFloatField(fields[64])
+	}
+	i++
+	if i <= dataLen {
+		s.E75 = ParseFloatField(fields[65])
+	}
+	i++
+	if i <= dataLen {
+		s.E75_BCL = ParseFloatField(fields[66])
+	}
+	i++
+	if i <= dataLen {
+		s.E75_BCU = ParseFloatField(fields[67])
+	}
+	i++
+	if i <= dataLen {
+		s.E90 = ParseFloatField(fields[68])
+	}
+	i++
+	if i <= dataLen {
+		s.E90_BCL = ParseFloatField(fields[69])
+	}
+	i++
+	if i <= dataLen {
+		s.E90_BCU = ParseFloatField(fields[70])
+	}
+	i++
+	if i <= dataLen {
+		s.EIQR = ParseFloatField(fields[71])
+	}
+	i++
+	if i <= dataLen {
+		s.EIQR_BCL = ParseFloatField(fields[72])
+	}
+	i++
+	if i <= dataLen {
+		s.EIQR_BCU = ParseFloatField(fields[73])
+	}
+	i++
+	if i <= dataLen {
+		s.MAD = ParseFloatField(fields[74])
+	}
+	i++
+	if i <= dataLen {
+		s.MAD_BCL = ParseFloatField(fields[75])
+	}
+	i++
+	if i <= dataLen {
+		s.MAD_BCU = ParseFloatField(fields[76])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR = ParseFloatField(fields[77])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_NCL = ParseFloatField(fields[78])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_NCU = ParseFloatField(fields[79])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_BCL = ParseFloatField(fields[80])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_BCU = ParseFloatField(fields[81])
+	}
+	i++
+	if i <= dataLen {
+		s.ME2 = ParseFloatField(fields[82])
+	}
+	i++
+	if i <= dataLen {
+		s.ME2_BCL = ParseFloatField(fields[83])
+	}
+	i++
+	if i <= dataLen {
+		s.ME2_BCU = ParseFloatField(fields[84])
+	}
+	i++
+	if i <= dataLen {
+		s.MSESS = ParseFloatField(fields[85])
+	}
+	i++
+	if i <= dataLen {
+		s.MSESS_BCL = ParseFloatField(fields[86])
+	}
+	i++
+	if i <= dataLen {
+		s.MSESS_BCU = ParseFloatField(fields[87])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSFA = ParseFloatField(fields[88])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSFA_BCL = ParseFloatField(fields[89])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSFA_BCU = ParseFloatField(fields[90])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSOA = ParseFloatField(fields[91])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSOA_BCL = ParseFloatField(fields[92])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSOA_BCU = ParseFloatField(fields[93])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR = ParseFloatField(fields[94])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR_BCL = ParseFloatField(fields[95])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR_BCU = ParseFloatField(fields[96])
+	}
+	i++
+	if i <= dataLen {
+		s.SI = ParseFloatField(fields[97])
+	}
+	i++
+	if i <= dataLen {
+		s.SI_BCL = ParseFloatField(fields[98])
+	}
+	i++
+	if i <= dataLen {
+		s.SI_BCU = ParseFloatField(fields[99])
+	}
+}
+
+func (s *STAT_CTC) fill_STAT_CTC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_OY = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_ON = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_OY = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_ON = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.EC_VALUE = ParseFloatField(fields[5])
+	}
+}
+
+func (s *STAT_CTS) fill_STAT_CTS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCU = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_BCL = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_BCU = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_NCL = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_NCU = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_BCL = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_BCU = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCU = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS_BCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS_BCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_NCL = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_NCU = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_BCL = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_BCU = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_NCL = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_NCU = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_BCL = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_BCU = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_NCL = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_NCU = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_BCL = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_BCU = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_NCL = ParseFloatField(fields[35])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_NCU = ParseFloatField(fields[36])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_BCL = ParseFloatField(fields[37])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_BCU = ParseFloatField(fields[38])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI = ParseFloatField(fields[39])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_NCL = ParseFloatField(fields[40])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_NCU = ParseFloatField(fields[41])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_BCL = ParseFloatField(fields[42])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_BCU = ParseFloatField(fields[43])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS = ParseFloatField(fields[44])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS_BCL = ParseFloatField(fields[45])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS_BCU = ParseFloatField(fields[46])
+	}
+	i++
+	if i <= dataLen {
+		s.HK = ParseFloatField(fields[47])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_NCL = ParseFloatField(fields[48])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_NCU = ParseFloatField(fields[49])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCL = ParseFloatField(fields[50])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCU = ParseFloatField(fields[51])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS = ParseFloatField(fields[52])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCL = ParseFloatField(fields[53])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCU = ParseFloatField(fields[54])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS = ParseFloatField(fields[55])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_NCL = ParseFloatField(fields[56])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_NCU = ParseFloatField(fields[57])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_BCL = ParseFloatField(fields[58])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_BCU = ParseFloatField(fields[59])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS = ParseFloatField(fields[60])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_NCL = ParseFloatField(fields[61])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_NCU = ParseFloatField(fields[62])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_BCL = ParseFloatField(fields[63])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_BCU = ParseFloatField(fields[64])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS = ParseFloatField(fields[65])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_NCL = ParseFloatField(fields[66])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_NCU = ParseFloatField(fields[67])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_BCL = ParseFloatField(fields[68])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_BCU = ParseFloatField(fields[69])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS = ParseFloatField(fields[70])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_NCL = ParseFloatField(fields[71])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_NCU = ParseFloatField(fields[72])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_BCL = ParseFloatField(fields[73])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_BCU = ParseFloatField(fields[74])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS = ParseFloatField(fields[75])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_NCL = ParseFloatField(fields[76])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_NCU = ParseFloatField(fields[77])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_BCL = ParseFloatField(fields[78])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_BCU = ParseFloatField(fields[79])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI = ParseFloatField(fields[80])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_NCL = ParseFloatField(fields[81])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_NCU = ParseFloatField(fields[82])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_BCL = ParseFloatField(fields[83])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_BCU = ParseFloatField(fields[84])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI = ParseFloatField(fields[85])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_NCL = ParseFloatField(fields[86])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_NCU = ParseFloatField(fields[87])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_BCL = ParseFloatField(fields[88])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_BCU = ParseFloatField(fields[89])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS = ParseFloatField(fields[90])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS_BCL = ParseFloatField(fields[91])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS_BCU = ParseFloatField(fields[92])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC = ParseFloatField(fields[93])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC_BCL = ParseFloatField(fields[94])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC_BCU = ParseFloatField(fields[95])
+	}
+	i++
+	if i <= dataLen {
+		s.EC_VALUE = ParseFloatField(fields[96])
+	}
+}
+
+func (s *STAT_DMAP) fill_STAT_DMAP(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FY = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.OY = ParseIntField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.BADDELEY = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.HAUSDORFF = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.MED_FO = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.MED_OF = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.MED_MIN = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.MED_MAX = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.MED_MEAN = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.FOM_FO = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.FOM_OF = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.FOM_MIN = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.FOM_MAX = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.FOM_MEAN = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.ZHU_FO = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.ZHU_OF = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.ZHU_MIN = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.ZHU_MAX = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.ZHU_MEAN = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.G = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.GBETA = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.BETA_VALUE = ParseFloatField(fields[23])
+	}
+}
+
+func (s *STAT_ECLV) fill_STAT_ECLV(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.VALUE_BASER = ParseIntField(fields[2])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[3])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"CL_", "VALUE_"}
+		s.PTS = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 4; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.PTS[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_ECNT) fill_STAT_ECNT(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.N_ENS = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPS = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPSS = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.IGN = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.ME = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_OERR = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE_OERR = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD_OERR = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD_PLUS_OERR = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPSCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPS_EMP = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPSCL_EMP = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPSS_EMP = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.CRPS_EMP_FAIR = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD_MD = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE_OERR = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.BIAS_RATIO = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.N_GE_OBS = ParseIntField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_GE_OBS = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.N_LT_OBS = ParseIntField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_LT_OBS = ParseFloatField(fields[24])
+	}
+}
+
+func (s *STAT_FHO) fill_STAT_FHO(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.F_RATE = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.H_RATE = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.O_RATE = ParseFloatField(fields[3])
+	}
+}
+
+func (s *STAT_GENMPR) fill_STAT_GENMPR(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.INDEX = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		if fields[2] != "NA" {
+			s.STORM_ID = fields[2]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.PROB_LEAD = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.PROB_VAL = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		if fields[5] != "NA" {
+			s.AGEN_INIT = fields[5]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[6] != "NA" {
+			s.AGEN_FHR = fields[6]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.AGEN_LAT = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.AGEN_LON = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.AGEN_DLAND = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.BGEN_LAT = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.BGEN_LON = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.BGEN_DLAND = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.GEN_DIST = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		if fields[14] != "NA" {
+			s.GEN_TDIFF = fields[14]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[15] != "NA" {
+			s.INIT_TDIFF = fields[15]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[16] != "NA" {
+			s.DEV_CAT = fields[16]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[17] != "NA" {
+			s.OPS_CAT = fields[17]
+		}
+	}
+}
+
+func (s *STAT_GRAD) fill_STAT_GRAD(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FGBAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.OGBAR = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.MGBAR = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.EGBAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.S1 = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.S1_OG = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FGOG_RATIO = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.DX = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.DY = ParseFloatField(fields[9])
+	}
+}
+
+func (s *STAT_ISC) fill_STAT_ISC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.TILE_DIM = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.TILE_XLL = ParseIntField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.TILE_YLL = ParseIntField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.NSCALE = ParseIntField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.ISCALE = ParseIntField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.MSE = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.ISC = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FENERGY2 = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.OENERGY2 = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS = ParseFloatField(fields[11])
+	}
+}
+
+func (s *STAT_MCTC) fill_STAT_MCTC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // these values seem to always be ints (or "NA" which is a missing value i.e. nil)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		s.CAT = make(map[string]interface{})
+		for i1 := 1; i1 <= count; i1++ {
+			for i2 := 1; i2 <= count; i2++ {
+				// generate the particular key for the map i.e. F1_O1, F1_O2, F1_O3, F1_O4, F2_O1, F2_O2, F2_O3, F2_O4, etc.
+				key := fmt.Sprintf("F%d_O%d", i1, i2)
+				index := (i1-1)*count + i2
+				if index >= len(fields) {
+					value = nil
+				} else {
+					value, err = strconv.Atoi(fields[index])
+				}
+				if err != nil {
+					value = nil
+				}
+				s.CAT[key] = value
+			}
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.EC_VALUE = ParseFloatField(fields[3])
+	}
+}
+
+func (s *STAT_MCTS) fill_STAT_MCTS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.N_CAT = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCL = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCU = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCL = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCU = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.HK = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCL = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCU = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCL = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCU = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.GER = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.GER_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.GER_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC_BCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_EC_BCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.EC_VALUE = ParseFloatField(fields[19])
+	}
+}
+
+func (s *STAT_MPR) fill_STAT_MPR(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.INDEX = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		if fields[2] != "NA" {
+			s.OBS_SID = fields[2]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LAT = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LON = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LVL = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_ELV = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FCST = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		if fields[9] != "NA" {
+			s.OBS_QC = fields[9]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.CLIMO_MEAN = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.CLIMO_STDEV = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.CLIMO_CDF = ParseFloatField(fields[12])
+	}
+}
+
+func (s *STAT_NBRCNT) fill_STAT_NBRCNT(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FBS = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FBS_BCL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FBS_BCU = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FSS = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.FSS_BCL = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.FSS_BCU = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.AFSS = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.AFSS_BCL = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.AFSS_BCU = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.UFSS = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.UFSS_BCL = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.UFSS_BCU = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.F_RATE = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.F_RATE_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.F_RATE_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.O_RATE = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.O_RATE_BCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.O_RATE_BCU = ParseFloatField(fields[18])
+	}
+}
+
+func (s *STAT_NBRCTC) fill_STAT_NBRCTC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_OY = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FY_ON = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_OY = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FN_ON = ParseFloatField(fields[4])
+	}
+}
+
+func (s *STAT_NBRCTS) fill_STAT_NBRCTS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCU = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_BCL = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_BCU = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_NCL = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_NCU = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_BCL = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.FMEAN_BCU = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_NCU = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.ACC_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS_BCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.FBIAS_BCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_NCL = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_NCU = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_BCL = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.PODY_BCU = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_NCL = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_NCU = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_BCL = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.PODN_BCU = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_NCL = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_NCU = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_BCL = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.POFD_BCU = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_NCL = ParseFloatField(fields[35])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_NCU = ParseFloatField(fields[36])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_BCL = ParseFloatField(fields[37])
+	}
+	i++
+	if i <= dataLen {
+		s.FAR_BCU = ParseFloatField(fields[38])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI = ParseFloatField(fields[39])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_NCL = ParseFloatField(fields[40])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_NCU = ParseFloatField(fields[41])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_BCL = ParseFloatField(fields[42])
+	}
+	i++
+	if i <= dataLen {
+		s.CSI_BCU = ParseFloatField(fields[43])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS = ParseFloatField(fields[44])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS_BCL = ParseFloatField(fields[45])
+	}
+	i++
+	if i <= dataLen {
+		s.GSS_BCU = ParseFloatField(fields[46])
+	}
+	i++
+	if i <= dataLen {
+		s.HK = ParseFloatField(fields[47])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_NCL = ParseFloatField(fields[48])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_NCU = ParseFloatField(fields[49])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCL = ParseFloatField(fields[50])
+	}
+	i++
+	if i <= dataLen {
+		s.HK_BCU = ParseFloatField(fields[51])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS = ParseFloatField(fields[52])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCL = ParseFloatField(fields[53])
+	}
+	i++
+	if i <= dataLen {
+		s.HSS_BCU = ParseFloatField(fields[54])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS = ParseFloatField(fields[55])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_NCL = ParseFloatField(fields[56])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_NCU = ParseFloatField(fields[57])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_BCL = ParseFloatField(fields[58])
+	}
+	i++
+	if i <= dataLen {
+		s.ODDS_BCU = ParseFloatField(fields[59])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS = ParseFloatField(fields[60])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_NCL = ParseFloatField(fields[61])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_NCU = ParseFloatField(fields[62])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_BCL = ParseFloatField(fields[63])
+	}
+	i++
+	if i <= dataLen {
+		s.LODDS_BCU = ParseFloatField(fields[64])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS = ParseFloatField(fields[65])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_NCL = ParseFloatField(fields[66])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_NCU = ParseFloatField(fields[67])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_BCL = ParseFloatField(fields[68])
+	}
+	i++
+	if i <= dataLen {
+		s.ORSS_BCU = ParseFloatField(fields[69])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS = ParseFloatField(fields[70])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_NCL = ParseFloatField(fields[71])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_NCU = ParseFloatField(fields[72])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_BCL = ParseFloatField(fields[73])
+	}
+	i++
+	if i <= dataLen {
+		s.EDS_BCU = ParseFloatField(fields[74])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS = ParseFloatField(fields[75])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_NCL = ParseFloatField(fields[76])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_NCU = ParseFloatField(fields[77])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_BCL = ParseFloatField(fields[78])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDS_BCU = ParseFloatField(fields[79])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI = ParseFloatField(fields[80])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_NCL = ParseFloatField(fields[81])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_NCU = ParseFloatField(fields[82])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_BCL = ParseFloatField(fields[83])
+	}
+	i++
+	if i <= dataLen {
+		s.EDI_BCU = ParseFloatField(fields[84])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI = ParseFloatField(fields[85])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_NCL = ParseFloatField(fields[86])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_NCU = ParseFloatField(fields[87])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_BCL = ParseFloatField(fields[88])
+	}
+	i++
+	if i <= dataLen {
+		s.SEDI_BCU = ParseFloatField(fields[89])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS = ParseFloatField(fields[90])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS_BCL = ParseFloatField(fields[91])
+	}
+	i++
+	if i <= dataLen {
+		s.BAGSS_BCU = ParseFloatField(fields[92])
+	}
+}
+
+func (s *STAT_ORANK) fill_STAT_ORANK(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.INDEX = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		if fields[2] != "NA" {
+			s.OBS_SID = fields[2]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LAT = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LON = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LVL = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_ELV = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.PIT = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.RANK = ParseIntField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.N_ENS_VLD = ParseIntField(fields[10])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[11])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"ENS_"}
+		s.ENS = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 12; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.Atoi(fields[index])
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.ENS[key] = value
+			}
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[13] != "NA" {
+			s.OBS_QC = fields[13]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.ENS_MEAN = ParseIntField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.CLIMO_MEAN = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.ENS_MEAN_OERR = ParseIntField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD_OERR = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.SPREAD_PLUS_OERR = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.CLIMO_STDEV = ParseFloatField(fields[20])
+	}
+}
+
+func (s *STAT_PCT) fill_STAT_PCT(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"THRESH_", "OY_", "ON_"}
+		s.THRESH = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.THRESH[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_PHIST) fill_STAT_PHIST(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.BIN_SIZE = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"BIN_"}
+		s.BIN = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 3; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.Atoi(fields[index])
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.BIN[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_PJC) fill_STAT_PJC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"THRESH_", "OY_TP_", "ON_TP_", "CALIBRATION_", "REFINEMENT", "LIKELIHOOD_", "BASER_"}
+		s.THRESH = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.THRESH[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_PRC) fill_STAT_PRC(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"THRESH_", "PODY_", "POFD_"}
+		s.THRESH = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.THRESH[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_PSTD) fill_STAT_PSTD(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"THRESH_"}
+		s.THRESH = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.THRESH[key] = value
+			}
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCL = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.BASER_NCU = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.RELIABILITY = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.RESOLUTION = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.UNCERTAINTY = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.ROC_AUC = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIER = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIER_NCL = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIER_NCU = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIERCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIERCL_NCL = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.BRIERCL_NCU = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.BSS = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.BSS_SMPL = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.THRESH_I = ParseIntField(fields[17])
+	}
+}
+
+func (s *STAT_RELP) fill_STAT_RELP(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"RELP_"}
+		s.ENS = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.ParseFloat(fields[index], 64)
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.ENS[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_RHIST) fill_STAT_RHIST(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"RANK_"}
+		s.RANK = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 2; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.Atoi(fields[index])
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.RANK[key] = value
+			}
+		}
+	}
+}
+
+func (s *STAT_RPS) fill_STAT_RPS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.N_PROB = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.RPS_REL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.RPS_RES = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.RPS_UNC = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.RPS = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.RPSS = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.RPSS_SMPL = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.RPS_COMP = ParseFloatField(fields[8])
+	}
+}
+
+func (s *STAT_SAL1L2) fill_STAT_SAL1L2(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FABAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.OABAR = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FOABAR = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FFABAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.OOABAR = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE = ParseFloatField(fields[6])
+	}
+}
+
+func (s *STAT_SEEPS) fill_STAT_SEEPS(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		if fields[1] != "NA" {
+			s.S12 = fields[1]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[2] != "NA" {
+			s.S13 = fields[2]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[3] != "NA" {
+			s.S21 = fields[3]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[4] != "NA" {
+			s.S23 = fields[4]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[5] != "NA" {
+			s.S31 = fields[5]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[6] != "NA" {
+			s.S32 = fields[6]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.PF1 = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.PF2 = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.PF3 = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.PV1 = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.PV2 = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.PV3 = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.MEAN_FCST = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.MEAN_OBS = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.SEEPS = ParseFloatField(fields[15])
+	}
+}
+
+func (s *STAT_SEEPS_MPR) fill_STAT_SEEPS_MPR(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		if fields[0] != "NA" {
+			s.OBS_SID = fields[0]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LAT = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_LON = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FCST = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		if fields[5] != "NA" {
+			s.OBS_QC = fields[5]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.FCST_CAT = ParseIntField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.OBS_CAT = ParseIntField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.P1 = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.P2 = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.T1 = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.T2 = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.SEEPS = ParseFloatField(fields[12])
+	}
+}
+
+func (s *STAT_SL1L2) fill_STAT_SL1L2(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FOBAR = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.FFBAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.OOBAR = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.MAE = ParseFloatField(fields[6])
+	}
+}
+
+func (s *STAT_SSIDX) fill_STAT_SSIDX(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		if fields[0] != "NA" {
+			s.FCST_MODEL = fields[0]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[1] != "NA" {
+			s.REF_MODEL = fields[1]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.N_INIT = ParseIntField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.N_TERM = ParseIntField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.N_VLD = ParseIntField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.SS_INDEX = ParseFloatField(fields[5])
+	}
+}
+
+func (s *STAT_SSVAR) fill_STAT_SSVAR(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.N_BIN = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.BIN_I = ParseIntField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.BIN_N = ParseIntField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.VAR_MIN = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.VAR_MAX = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.VAR_MEAN = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FOBAR = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.FFBAR = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.OOBAR = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_NCL = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_NCU = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_NCL = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_NCU = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_NCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_NCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_NCL = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_NCU = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_NCL = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.PR_CORR_NCU = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.ME = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_NCL = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.ME_NCU = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_NCL = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.ESTDEV_NCU = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.MBIAS = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.MSE = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.BCMSE = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSE = ParseFloatField(fields[34])
+	}
+}
+
+func (s *STAT_VAL1L2) fill_STAT_VAL1L2(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.UFABAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.VFABAR = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.UOABAR = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.VOABAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.UVFOABAR = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.UVFFABAR = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.UVOOABAR = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FA_SPEED_BAR = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.OA_SPEED_BAR = ParseFloatField(fields[9])
+	}
+}
+
+func (s *STAT_VCNT) fill_STAT_VCNT(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_BCL = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_BCU = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_BCL = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_BCU = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.FS_RMS = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.FS_RMS_BCL = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.FS_RMS_BCU = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.OS_RMS = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.OS_RMS_BCL = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.OS_RMS_BCU = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.MSVE = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.MSVE_BCL = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.MSVE_BCU = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSVE = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSVE_BCL = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.RMSVE_BCU = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_BCL = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.FSTDEV_BCU = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_BCL = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.OSTDEV_BCU = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.FDIR = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.FDIR_BCL = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.FDIR_BCU = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.ODIR = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.ODIR_BCL = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.ODIR_BCU = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_SPEED = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_SPEED_BCL = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.FBAR_SPEED_BCU = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_SPEED = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_SPEED_BCL = ParseFloatField(fields[35])
+	}
+	i++
+	if i <= dataLen {
+		s.OBAR_SPEED_BCU = ParseFloatField(fields[36])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_SPEED = ParseFloatField(fields[37])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_SPEED_BCL = ParseFloatField(fields[38])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_SPEED_BCU = ParseFloatField(fields[39])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_DIR = ParseFloatField(fields[40])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_DIR_BCL = ParseFloatField(fields[41])
+	}
+	i++
+	if i <= dataLen {
+		s.VDIFF_DIR_BCU = ParseFloatField(fields[42])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ERR = ParseFloatField(fields[43])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ERR_BCL = ParseFloatField(fields[44])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ERR_BCU = ParseFloatField(fields[45])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ABSERR = ParseFloatField(fields[46])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ABSERR_BCL = ParseFloatField(fields[47])
+	}
+	i++
+	if i <= dataLen {
+		s.SPEED_ABSERR_BCU = ParseFloatField(fields[48])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ERR = ParseFloatField(fields[49])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ERR_BCL = ParseFloatField(fields[50])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ERR_BCU = ParseFloatField(fields[51])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ABSERR = ParseFloatField(fields[52])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ABSERR_BCL = ParseFloatField(fields[53])
+	}
+	i++
+	if i <= dataLen {
+		s.DIR_ABSERR_BCU = ParseFloatField(fields[54])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR = ParseFloatField(fields[55])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_NCL = ParseFloatField(fields[56])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_NCU = ParseFloatField(fields[57])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_BCL = ParseFloatField(fields[58])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_BCU = ParseFloatField(fields[59])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR = ParseFloatField(fields[60])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR_BCL = ParseFloatField(fields[61])
+	}
+	i++
+	if i <= dataLen {
+		s.ANOM_CORR_UNCNTR_BCU = ParseFloatField(fields[62])
+	}
+}
+
+func (s *STAT_VL1L2) fill_STAT_VL1L2(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.UFBAR = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.VFBAR = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.UOBAR = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		s.VOBAR = ParseFloatField(fields[4])
+	}
+	i++
+	if i <= dataLen {
+		s.UVFOBAR = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.UVFFBAR = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.UVOOBAR = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.F_SPEED_BAR = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.O_SPEED_BAR = ParseFloatField(fields[9])
+	}
+}
+
+func (s *TCST_PROBRIRW) fill_TCST_PROBRIRW(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.ALAT = ParseFloatField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.ALON = ParseFloatField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.BLAT = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		s.BLON = ParseFloatField(fields[3])
+	}
+	i++
+	if i <= dataLen {
+		if fields[4] != "NA" {
+			s.INITIALS = fields[4]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.TK_ERR = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.X_ERR = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.Y_ERR = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.ADLAND = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.BDLAND = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.RIRW_BEG = ParseIntField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.RIRW_END = ParseIntField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.RIRW_WINDOW = ParseIntField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.AWIND_END = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.BWIND_BEG = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.BWIND_END = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.BDELTA = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.BDELTA_MAX = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		if fields[18] != "NA" {
+			s.BLEVEL_BEG = fields[18]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[19] != "NA" {
+			s.BLEVEL_END = fields[19]
+		}
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[20])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"THRESH_", "PROB_"}
+		s.THRESH = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 21; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value, err = strconv.Atoi(fields[index])
+					if err != nil { // sometimes there can be these NA values in the data, a missing value is nil
+						value = nil
+					}
+				}
+				s.THRESH[key] = value
+			}
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.INIT = ParseIntField(fields[23])
+	}
+}
+
+func (s *TCST_TCDIAG) fill_TCST_TCDIAG(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.INDEX = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		s.DIAG_SOURCE = ParseFloatField(fields[2])
+	}
+	i++
+	if i <= dataLen {
+		if fields[3] != "NA" {
+			s.TRACK_SOURCE = fields[3]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[4] != "NA" {
+			s.FIELD_SOURCE = fields[4]
+		}
+	}
+	i++
+	if i <= dataLen { // the first field of the repeating fields is the TOTAL, the second field is the 1st dimenSion of the 1st sequence (there might be only one sequence)
+		var value interface{}
+		count, err := strconv.Atoi(fields[5])
+		if err != nil {
+			count = 0
+		}
+		keyPrefixes := []string{"DIAG_", "VALUE_"}
+		s.DIAG = make(map[string]interface{})
+		for group := 1; group <= count; group++ {
+			for index := 6; index <= len(keyPrefixes); index++ {
+				key := fmt.Sprintf("%s_%d", keyPrefixes[index-1], index)
+				if index > len(fields) { // sometimes the data line is truncated - the missing data is nil
+					value = nil
+				} else {
+					value = fields[index]
+					if value == "NA" { // a missing value is nil
+						value = nil
+					}
+				}
+				s.DIAG[key] = value
+			}
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.INIT = ParseIntField(fields[8])
+	}
+}
+
+func (s *TCST_TCMPR) fill_TCST_TCMPR(fields []string) {
+	dataLen := len(fields) - 1
+	i := -1
+	i++
+	if i <= dataLen {
+		s.TOTAL = ParseIntField(fields[0])
+	}
+	i++
+	if i <= dataLen {
+		s.INDEX = ParseIntField(fields[1])
+	}
+	i++
+	if i <= dataLen {
+		if fields[2] != "NA" {
+			s.LEVEL = fields[2]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[3] != "NA" {
+			s.WATCH_WARN = fields[3]
+		}
+	}
+	i++
+	if i <= dataLen {
+		if fields[4] != "NA" {
+			s.INITIALS = fields[4]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.ALAT = ParseFloatField(fields[5])
+	}
+	i++
+	if i <= dataLen {
+		s.ALON = ParseFloatField(fields[6])
+	}
+	i++
+	if i <= dataLen {
+		s.BLAT = ParseFloatField(fields[7])
+	}
+	i++
+	if i <= dataLen {
+		s.BLON = ParseFloatField(fields[8])
+	}
+	i++
+	if i <= dataLen {
+		s.TK_ERR = ParseFloatField(fields[9])
+	}
+	i++
+	if i <= dataLen {
+		s.X_ERR = ParseFloatField(fields[10])
+	}
+	i++
+	if i <= dataLen {
+		s.Y_ERR = ParseFloatField(fields[11])
+	}
+	i++
+	if i <= dataLen {
+		s.ALTK_ERR = ParseFloatField(fields[12])
+	}
+	i++
+	if i <= dataLen {
+		s.CRTK_ERR = ParseFloatField(fields[13])
+	}
+	i++
+	if i <= dataLen {
+		s.ADLAND = ParseFloatField(fields[14])
+	}
+	i++
+	if i <= dataLen {
+		s.BDLAND = ParseFloatField(fields[15])
+	}
+	i++
+	if i <= dataLen {
+		s.AMSLP = ParseFloatField(fields[16])
+	}
+	i++
+	if i <= dataLen {
+		s.BMSLP = ParseFloatField(fields[17])
+	}
+	i++
+	if i <= dataLen {
+		s.AMAX_WIND = ParseFloatField(fields[18])
+	}
+	i++
+	if i <= dataLen {
+		s.BMAX_WIND = ParseFloatField(fields[19])
+	}
+	i++
+	if i <= dataLen {
+		s.AAL_WIND_34 = ParseFloatField(fields[20])
+	}
+	i++
+	if i <= dataLen {
+		s.BAL_WIND_34 = ParseFloatField(fields[21])
+	}
+	i++
+	if i <= dataLen {
+		s.ANE_WIND_34 = ParseFloatField(fields[22])
+	}
+	i++
+	if i <= dataLen {
+		s.BNE_WIND_34 = ParseFloatField(fields[23])
+	}
+	i++
+	if i <= dataLen {
+		s.ASE_WIND_34 = ParseFloatField(fields[24])
+	}
+	i++
+	if i <= dataLen {
+		s.BSE_WIND_34 = ParseFloatField(fields[25])
+	}
+	i++
+	if i <= dataLen {
+		s.ASW_WIND_34 = ParseFloatField(fields[26])
+	}
+	i++
+	if i <= dataLen {
+		s.BSW_WIND_34 = ParseFloatField(fields[27])
+	}
+	i++
+	if i <= dataLen {
+		s.ANW_WIND_34 = ParseFloatField(fields[28])
+	}
+	i++
+	if i <= dataLen {
+		s.BNW_WIND_34 = ParseFloatField(fields[29])
+	}
+	i++
+	if i <= dataLen {
+		s.AAL_WIND_50 = ParseFloatField(fields[30])
+	}
+	i++
+	if i <= dataLen {
+		s.BAL_WIND_50 = ParseFloatField(fields[31])
+	}
+	i++
+	if i <= dataLen {
+		s.ANE_WIND_50 = ParseFloatField(fields[32])
+	}
+	i++
+	if i <= dataLen {
+		s.BNE_WIND_50 = ParseFloatField(fields[33])
+	}
+	i++
+	if i <= dataLen {
+		s.ASE_WIND_50 = ParseFloatField(fields[34])
+	}
+	i++
+	if i <= dataLen {
+		s.BSE_WIND_50 = ParseFloatField(fields[35])
+	}
+	i++
+	if i <= dataLen {
+		s.ASW_WIND_50 = ParseFloatField(fields[36])
+	}
+	i++
+	if i <= dataLen {
+		s.BSW_WIND_50 = ParseFloatField(fields[37])
+	}
+	i++
+	if i <= dataLen {
+		s.ANW_WIND_50 = ParseFloatField(fields[38])
+	}
+	i++
+	if i <= dataLen {
+		s.BNW_WIND_50 = ParseFloatField(fields[39])
+	}
+	i++
+	if i <= dataLen {
+		s.AAL_WIND_64 = ParseFloatField(fields[40])
+	}
+	i++
+	if i <= dataLen {
+		s.BAL_WIND_64 = ParseFloatField(fields[41])
+	}
+	i++
+	if i <= dataLen {
+		s.ANE_WIND_64 = ParseFloatField(fields[42])
+	}
+	i++
+	if i <= dataLen {
+		s.BNE_WIND_64 = ParseFloatField(fields[43])
+	}
+	i++
+	if i <= dataLen {
+		s.ASE_WIND_64 = ParseFloatField(fields[44])
+	}
+	i++
+	if i <= dataLen {
+		s.BSE_WIND_64 = ParseFloatField(fields[45])
+	}
+	i++
+	if i <= dataLen {
+		s.ASW_WIND_64 = ParseFloatField(fields[46])
+	}
+	i++
+	if i <= dataLen {
+		s.BSW_WIND_64 = ParseFloatField(fields[47])
+	}
+	i++
+	if i <= dataLen {
+		s.ANW_WIND_64 = ParseFloatField(fields[48])
+	}
+	i++
+	if i <= dataLen {
+		s.BNW_WIND_64 = ParseFloatField(fields[49])
+	}
+	i++
+	if i <= dataLen {
+		if fields[50] != "NA" {
+			s.ARADP = fields[50]
+		}
+	}
+	i++
+	if i <= dataLen {
+		s.BRADP = ParseFloatField(fields[51])
+	}
+	i++
+	if i <= dataLen {
+		s.ARRP = ParseIntField(fields[52])
+	}
+	i++
+	if i <= dataLen {
+		s.BRRP = ParseFloatField(fields[53])
+	}
+	i++
+	if i <= dataLen {
+		s.AMRD = ParseIntField(fields[54])
+	}
+	i++
+	if i <= dataLen {
+		s.BMRD = ParseFloatField(fields[55])
+	}
+	i++
+	if i <= dataLen {
+		s.AGUSTS = ParseIntField(fields[56])
+	}
+	i++
+	if i <= dataLen {
+		s.BGUSTS = ParseFloatField(fields[57])
+	}
+	i++
+	if i <= dataLen {
+		s.AEYE = ParseIntField(fields[58])
+	}
+	i++
+	if i <= dataLen {
+		s.BEYE = ParseFloatField(fields[59])
+	}
+	i++
+	if i <= dataLen {
+		s.ADIR = ParseIntField(fields[60])
+	}
+	i++
+	if i <= dataLen {
+		s.BDIR = ParseFloatField(fields[61])
+	}
+	i++
+	if i <= dataLen {
+		s.ASPEED = ParseIntField(fields[62])
+	}
+	i++
+	if i <= dataLen {
+		s.BSPEED = ParseFloatField(fields[63])
+	}
+	i++
+	if i <= dataLen {
+		s.ADEPTH = ParseIntField(fields[64])
+	}
+	i++
+	if i <= dataLen {
+		s.BDEPTH = ParseFloatField(fields[65])
+	}
+	i++
+	if i <= dataLen {
+		s.NUM_MEMBERS = ParseFloatField(fields[66])
+	}
+	i++
+	if i <= dataLen {
+		s.TRACK_SPREAD = ParseFloatField(fields[67])
+	}
+	i++
+	if i <= dataLen {
+		s.TRACK_STDEV = ParseFloatField(fields[68])
+	}
+	i++
+	if i <= dataLen {
+		s.MSLP_STDEV = ParseFloatField(fields[69])
+	}
+	i++
+	if i <= dataLen {
+		s.MAX_WIND_STDEV = ParseFloatField(fields[70])
+	}
+	i++
+	if i <= dataLen {
+		s.INIT = ParseIntField(fields[71])
+	}
+}
+
+// getDocForId functions
+func GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {
+	doc := make(map[string]interface{})
+	// add the metadata to the doc
+	for key, value := range metaDataMap {
+		doc[key] = value
+	}
+	switch fileLineType {
+	case "MODE_CTS":
+		elem := MODE_CTS{}
+		elem.fill_MODE_CTS_Header(headerData, &doc)
+		elem.fill_MODE_CTS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]MODE_CTS)
+		}
+		if val, ok := (doc)["data"].(map[string]MODE_CTS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "MODE_OBJ":
+		elem := MODE_OBJ{}
+		elem.fill_MODE_OBJ_Header(headerData, &doc)
+		elem.fill_MODE_OBJ(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]MODE_OBJ)
+		}
+		if val, ok := (doc)["data"].(map[string]MODE_OBJ); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_CNT":
+		elem := STAT_CNT{}
+		elem.fill_STAT_CNT_Header(headerData, &doc)
+		elem.fill_STAT_CNT(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_CNT)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_CNT); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_CTC":
+		elem := STAT_CTC{}
+		elem.fill_STAT_CTC_Header(headerData, &doc)
+		elem.fill_STAT_CTC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_CTC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_CTC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_CTS":
+		elem := STAT_CTS{}
+		elem.fill_STAT_CTS_Header(headerData, &doc)
+		elem.fill_STAT_CTS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_CTS)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_CTS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_DMAP":
+		elem := STAT_DMAP{}
+		elem.fill_STAT_DMAP_Header(headerData, &doc)
+		elem.fill_STAT_DMAP(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_DMAP)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_DMAP); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_ECLV":
+		elem := STAT_ECLV{}
+		elem.fill_STAT_ECLV_Header(headerData, &doc)
+		elem.fill_STAT_ECLV(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_ECLV)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_ECLV); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_ECNT":
+		elem := STAT_ECNT{}
+		elem.fill_STAT_ECNT_Header(headerData, &doc)
+		elem.fill_STAT_ECNT(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_ECNT)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_ECNT); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_FHO":
+		elem := STAT_FHO{}
+		elem.fill_STAT_FHO_Header(headerData, &doc)
+		elem.fill_STAT_FHO(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_FHO)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_FHO); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_GENMPR":
+		elem := STAT_GENMPR{}
+		elem.fill_STAT_GENMPR_Header(headerData, &doc)
+		elem.fill_STAT_GENMPR(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_GENMPR)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_GENMPR); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_GRAD":
+		elem := STAT_GRAD{}
+		elem.fill_STAT_GRAD_Header(headerData, &doc)
+		elem.fill_STAT_GRAD(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_GRAD)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_GRAD); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_ISC":
+		elem := STAT_ISC{}
+		elem.fill_STAT_ISC_Header(headerData, &doc)
+		elem.fill_STAT_ISC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_ISC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_ISC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_MCTC":
+		elem := STAT_MCTC{}
+		elem.fill_STAT_MCTC_Header(headerData, &doc)
+		elem.fill_STAT_MCTC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_MCTC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_MCTC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_MCTS":
+		elem := STAT_MCTS{}
+		elem.fill_STAT_MCTS_Header(headerData, &doc)
+		elem.fill_STAT_MCTS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_MCTS)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_MCTS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_MPR":
+		elem := STAT_MPR{}
+		elem.fill_STAT_MPR_Header(headerData, &doc)
+		elem.fill_STAT_MPR(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_MPR)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_MPR); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_NBRCNT":
+		elem := STAT_NBRCNT{}
+		elem.fill_STAT_NBRCNT_Header(headerData, &doc)
+		elem.fill_STAT_NBRCNT(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_NBRCNT)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_NBRCNT); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_NBRCTC":
+		elem := STAT_NBRCTC{}
+		elem.fill_STAT_NBRCTC_Header(headerData, &doc)
+		elem.fill_STAT_NBRCTC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_NBRCTC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_NBRCTC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_NBRCTS":
+		elem := STAT_NBRCTS{}
+		elem.fill_STAT_NBRCTS_Header(headerData, &doc)
+		elem.fill_STAT_NBRCTS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_NBRCTS)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_NBRCTS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_ORANK":
+		elem := STAT_ORANK{}
+		elem.fill_STAT_ORANK_Header(headerData, &doc)
+		elem.fill_STAT_ORANK(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_ORANK)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_ORANK); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_PCT":
+		elem := STAT_PCT{}
+		elem.fill_STAT_PCT_Header(headerData, &doc)
+		elem.fill_STAT_PCT(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_PCT)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_PCT); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_PHIST":
+		elem := STAT_PHIST{}
+		elem.fill_STAT_PHIST_Header(headerData, &doc)
+		elem.fill_STAT_PHIST(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_PHIST)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_PHIST); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_PJC":
+		elem := STAT_PJC{}
+		elem.fill_STAT_PJC_Header(headerData, &doc)
+		elem.fill_STAT_PJC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_PJC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_PJC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_PRC":
+		elem := STAT_PRC{}
+		elem.fill_STAT_PRC_Header(headerData, &doc)
+		elem.fill_STAT_PRC(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_PRC)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_PRC); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_PSTD":
+		elem := STAT_PSTD{}
+		elem.fill_STAT_PSTD_Header(headerData, &doc)
+		elem.fill_STAT_PSTD(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_PSTD)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_PSTD); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_RELP":
+		elem := STAT_RELP{}
+		elem.fill_STAT_RELP_Header(headerData, &doc)
+		elem.fill_STAT_RELP(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_RELP)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_RELP); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_RHIST":
+		elem := STAT_RHIST{}
+		elem.fill_STAT_RHIST_Header(headerData, &doc)
+		elem.fill_STAT_RHIST(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_RHIST)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_RHIST); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_RPS":
+		elem := STAT_RPS{}
+		elem.fill_STAT_RPS_Header(headerData, &doc)
+		elem.fill_STAT_RPS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_RPS)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_RPS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SAL1L2":
+		elem := STAT_SAL1L2{}
+		elem.fill_STAT_SAL1L2_Header(headerData, &doc)
+		elem.fill_STAT_SAL1L2(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SAL1L2)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SAL1L2); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SEEPS":
+		elem := STAT_SEEPS{}
+		elem.fill_STAT_SEEPS_Header(headerData, &doc)
+		elem.fill_STAT_SEEPS(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SEEPS)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SEEPS); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SEEPS_MPR":
+		elem := STAT_SEEPS_MPR{}
+		elem.fill_STAT_SEEPS_MPR_Header(headerData, &doc)
+		elem.fill_STAT_SEEPS_MPR(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SEEPS_MPR)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SEEPS_MPR); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SL1L2":
+		elem := STAT_SL1L2{}
+		elem.fill_STAT_SL1L2_Header(headerData, &doc)
+		elem.fill_STAT_SL1L2(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SL1L2)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SL1L2); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SSIDX":
+		elem := STAT_SSIDX{}
+		elem.fill_STAT_SSIDX_Header(headerData, &doc)
+		elem.fill_STAT_SSIDX(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SSIDX)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SSIDX); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_SSVAR":
+		elem := STAT_SSVAR{}
+		elem.fill_STAT_SSVAR_Header(headerData, &doc)
+		elem.fill_STAT_SSVAR(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_SSVAR)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_SSVAR); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_VAL1L2":
+		elem := STAT_VAL1L2{}
+		elem.fill_STAT_VAL1L2_Header(headerData, &doc)
+		elem.fill_STAT_VAL1L2(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_VAL1L2)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_VAL1L2); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_VCNT":
+		elem := STAT_VCNT{}
+		elem.fill_STAT_VCNT_Header(headerData, &doc)
+		elem.fill_STAT_VCNT(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_VCNT)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_VCNT); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "STAT_VL1L2":
+		elem := STAT_VL1L2{}
+		elem.fill_STAT_VL1L2_Header(headerData, &doc)
+		elem.fill_STAT_VL1L2(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]STAT_VL1L2)
+		}
+		if val, ok := (doc)["data"].(map[string]STAT_VL1L2); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "TCST_PROBRIRW":
+		elem := TCST_PROBRIRW{}
+		elem.fill_TCST_PROBRIRW_Header(headerData, &doc)
+		elem.fill_TCST_PROBRIRW(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]TCST_PROBRIRW)
+		}
+		if val, ok := (doc)["data"].(map[string]TCST_PROBRIRW); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "TCST_TCDIAG":
+		elem := TCST_TCDIAG{}
+		elem.fill_TCST_TCDIAG_Header(headerData, &doc)
+		elem.fill_TCST_TCDIAG(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]TCST_TCDIAG)
+		}
+		if val, ok := (doc)["data"].(map[string]TCST_TCDIAG); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	case "TCST_TCMPR":
+		elem := TCST_TCMPR{}
+		elem.fill_TCST_TCMPR_Header(headerData, &doc)
+		elem.fill_TCST_TCMPR(dataData)
+		if exists := (doc)["data"]; exists == nil {
+			(doc)["data"] = make(map[string]TCST_TCMPR)
+		}
+		if val, ok := (doc)["data"].(map[string]TCST_TCMPR); ok {
+			val[dataKey] = elem
+			(doc)["data"] = val
+		}
+	default:
+		return nil, errors.New("GetDocForId: Unknown file_line type:" + fileLineType)
+	}
+	return doc, nil
+}
+
+// addDataElement functions
+func AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {
+	switch fileLineType {
+	case "MODE_CTS":
+		elem := MODE_CTS{}
+		elem.fill_MODE_CTS(dataData)
+		if val, ok := (*doc)["data"].(map[string]MODE_CTS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "MODE_OBJ":
+		elem := MODE_OBJ{}
+		elem.fill_MODE_OBJ(dataData)
+		if val, ok := (*doc)["data"].(map[string]MODE_OBJ); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_CNT":
+		elem := STAT_CNT{}
+		elem.fill_STAT_CNT(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_CNT); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_CTC":
+		elem := STAT_CTC{}
+		elem.fill_STAT_CTC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_CTC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_CTS":
+		elem := STAT_CTS{}
+		elem.fill_STAT_CTS(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_CTS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_DMAP":
+		elem := STAT_DMAP{}
+		elem.fill_STAT_DMAP(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_DMAP); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_ECLV":
+		elem := STAT_ECLV{}
+		elem.fill_STAT_ECLV(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_ECLV); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_ECNT":
+		elem := STAT_ECNT{}
+		elem.fill_STAT_ECNT(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_ECNT); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_FHO":
+		elem := STAT_FHO{}
+		elem.fill_STAT_FHO(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_FHO); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_GENMPR":
+		elem := STAT_GENMPR{}
+		elem.fill_STAT_GENMPR(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_GENMPR); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_GRAD":
+		elem := STAT_GRAD{}
+		elem.fill_STAT_GRAD(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_GRAD); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_ISC":
+		elem := STAT_ISC{}
+		elem.fill_STAT_ISC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_ISC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_MCTC":
+		elem := STAT_MCTC{}
+		elem.fill_STAT_MCTC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_MCTC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_MCTS":
+		elem := STAT_MCTS{}
+		elem.fill_STAT_MCTS(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_MCTS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_MPR":
+		elem := STAT_MPR{}
+		elem.fill_STAT_MPR(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_MPR); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_NBRCNT":
+		elem := STAT_NBRCNT{}
+		elem.fill_STAT_NBRCNT(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_NBRCNT); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_NBRCTC":
+		elem := STAT_NBRCTC{}
+		elem.fill_STAT_NBRCTC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_NBRCTC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_NBRCTS":
+		elem := STAT_NBRCTS{}
+		elem.fill_STAT_NBRCTS(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_NBRCTS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_ORANK":
+		elem := STAT_ORANK{}
+		elem.fill_STAT_ORANK(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_ORANK); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_PCT":
+		elem := STAT_PCT{}
+		elem.fill_STAT_PCT(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_PCT); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_PHIST":
+		elem := STAT_PHIST{}
+		elem.fill_STAT_PHIST(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_PHIST); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_PJC":
+		elem := STAT_PJC{}
+		elem.fill_STAT_PJC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_PJC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_PRC":
+		elem := STAT_PRC{}
+		elem.fill_STAT_PRC(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_PRC); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_PSTD":
+		elem := STAT_PSTD{}
+		elem.fill_STAT_PSTD(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_PSTD); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_RELP":
+		elem := STAT_RELP{}
+		elem.fill_STAT_RELP(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_RELP); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_RHIST":
+		elem := STAT_RHIST{}
+		elem.fill_STAT_RHIST(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_RHIST); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_RPS":
+		elem := STAT_RPS{}
+		elem.fill_STAT_RPS(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_RPS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SAL1L2":
+		elem := STAT_SAL1L2{}
+		elem.fill_STAT_SAL1L2(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SAL1L2); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SEEPS":
+		elem := STAT_SEEPS{}
+		elem.fill_STAT_SEEPS(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SEEPS); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SEEPS_MPR":
+		elem := STAT_SEEPS_MPR{}
+		elem.fill_STAT_SEEPS_MPR(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SEEPS_MPR); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SL1L2":
+		elem := STAT_SL1L2{}
+		elem.fill_STAT_SL1L2(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SL1L2); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SSIDX":
+		elem := STAT_SSIDX{}
+		elem.fill_STAT_SSIDX(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SSIDX); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_SSVAR":
+		elem := STAT_SSVAR{}
+		elem.fill_STAT_SSVAR(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_SSVAR); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_VAL1L2":
+		elem := STAT_VAL1L2{}
+		elem.fill_STAT_VAL1L2(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_VAL1L2); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_VCNT":
+		elem := STAT_VCNT{}
+		elem.fill_STAT_VCNT(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_VCNT); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "STAT_VL1L2":
+		elem := STAT_VL1L2{}
+		elem.fill_STAT_VL1L2(dataData)
+		if val, ok := (*doc)["data"].(map[string]STAT_VL1L2); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "TCST_PROBRIRW":
+		elem := TCST_PROBRIRW{}
+		elem.fill_TCST_PROBRIRW(dataData)
+		if val, ok := (*doc)["data"].(map[string]TCST_PROBRIRW); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "TCST_TCDIAG":
+		elem := TCST_TCDIAG{}
+		elem.fill_TCST_TCDIAG(dataData)
+		if val, ok := (*doc)["data"].(map[string]TCST_TCDIAG); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	case "TCST_TCMPR":
+		elem := TCST_TCMPR{}
+		elem.fill_TCST_TCMPR(dataData)
+		if val, ok := (*doc)["data"].(map[string]TCST_TCMPR); ok {
+			val[dataKey] = elem
+			(*doc)["data"] = val
+		}
+	default:
+		return nil, errors.New("AddDataElement: Unknown file_line type:" + fileLineType)
+	}
+	return *doc, nil
+}
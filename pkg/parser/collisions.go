@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+/*
+CollisionPolicy is what is done with the data of a line whose document id and data key are already in the document,
+i.e. when a MET job was re-run, when directories overlap or when the data key of a line type does not tell its lines
+apart. A collision whose data is the same as the data in the document is a duplicate. The collisions and duplicates
+are counted per fileLineType in the FileSummary and the DirectorySummary, and the first collision of each line type
+is reported to the warning handler, unless the policy is CollisionReject.
+*/
+type CollisionPolicy int
+
+const (
+	// CollisionLastWins replaces the data in the document with the data of the line, like the parser always did. This is the default.
+	CollisionLastWins CollisionPolicy = iota
+	// CollisionFirstWins keeps the data in the document and drops the data of the line.
+	CollisionFirstWins
+	// CollisionReject keeps the data in the document and rejects the line with a CollisionError.
+	CollisionReject
+	// CollisionKeepAll keeps the data in the document and adds the data of the line with a suffix to the data key,
+	// i.e. 120000_2, 120000_3 and so on.
+	CollisionKeepAll
+)
+
+// WithCollisionPolicy sets what is done with the data of a line whose document id and data key are already in the document, the default is CollisionLastWins.
+func WithCollisionPolicy(policy CollisionPolicy) Option {
+	return func(p *Parser) {
+		p.collisionPolicy = policy
+	}
+}
+
+// CollisionError reports the data of a line whose document id and data key are already in the document.
+type CollisionError struct {
+	FileLineType string
+	Id           string
+	DataKey      string
+	Duplicate    bool // the data is the same as the data in the document
+}
+
+func (e *CollisionError) Error() string {
+	kind := "different"
+	if e.Duplicate {
+		kind = "the same"
+	}
+	return fmt.Sprintf("%v: the %s data %s of document %s is already in the document with %s data", ErrCollision, e.FileLineType, e.DataKey, e.Id, kind)
+}
+
+func (e *CollisionError) Unwrap() error {
+	return ErrCollision
+}
+
+// collisionCounts are the collisions and duplicates of merging a document.
+type collisionCounts struct {
+	collisions int
+	duplicates int
+}
+
+// addCollisions adds the collision counts of a fileLineType to the collisions and duplicates maps of a summary.
+func addCollisions(collisions map[string]int, duplicates map[string]int, fileLineType string, counts collisionCounts) {
+	if counts.collisions > 0 {
+		collisions[fileLineType] += counts.collisions
+	}
+	if counts.duplicates > 0 {
+		duplicates[fileLineType] += counts.duplicates
+	}
+}
+
+/*
+mergeDocument merges a document into the store the way the CollisionPolicy says. The data keys of the document are
+checked against the document in the store and merged under one lock, so concurrent lines that go to the same store
+do not miss each other's data. The lock is the one of the store if it is a mergeLocker, and the one of the Parser
+for the other stores. A CollisionError is only returned for the CollisionReject policy, the other data of the
+document is merged anyway.
+*/
+func (p *Parser) mergeDocument(store DocumentStore, id string, fileLineType string, doc map[string]interface{}) (collisionCounts, error) {
+	var counts collisionCounts
+	mu := p.mergeMu
+	if locker, ok := store.(mergeLocker); ok {
+		mu = locker.mergeLock()
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	existing, err := store.Get(id)
+	if err != nil {
+		if !isDocNotFound(err) {
+			return counts, err
+		}
+		return counts, store.Merge(id, doc)
+	}
+	dst := reflect.ValueOf(existing["data"])
+	src := reflect.ValueOf(doc["data"])
+	if !dst.IsValid() || dst.Kind() != reflect.Map || !src.IsValid() || src.Kind() != reflect.Map {
+		return counts, store.Merge(id, doc)
+	}
+	var collision *CollisionError
+	for _, key := range src.MapKeys() {
+		existingValue := dst.MapIndex(key)
+		if !existingValue.IsValid() {
+			continue
+		}
+		value := src.MapIndex(key)
+		duplicate := reflect.DeepEqual(existingValue.Interface(), value.Interface())
+		counts.collisions++
+		if duplicate {
+			counts.duplicates++
+		}
+		if collision == nil {
+			collision = &CollisionError{FileLineType: fileLineType, Id: id, DataKey: key.String(), Duplicate: duplicate}
+		}
+		switch p.collisionPolicy {
+		case CollisionFirstWins, CollisionReject:
+			src.SetMapIndex(key, reflect.Value{})
+		case CollisionKeepAll:
+			src.SetMapIndex(key, reflect.Value{})
+			src.SetMapIndex(reflect.ValueOf(freeDataKey(dst, src, key.String())), value)
+		}
+	}
+	if collision != nil && p.collisionPolicy != CollisionReject && p.warningHandler != nil {
+		if _, warned := p.collisionWarnings.LoadOrStore(fileLineType, true); !warned {
+			p.warningHandler(collision)
+		}
+	}
+	if src.Len() > 0 {
+		if err := store.Merge(id, doc); err != nil {
+			return counts, err
+		}
+	}
+	if collision != nil && p.collisionPolicy == CollisionReject {
+		return counts, collision
+	}
+	return counts, nil
+}
+
+// freeDataKey returns the first data key dataKey_2, dataKey_3 ... that is in neither of the data sections.
+func freeDataKey(dst reflect.Value, src reflect.Value, dataKey string) string {
+	for n := 2; ; n++ {
+		key := dataKey + "_" + strconv.Itoa(n)
+		if !dst.MapIndex(reflect.ValueOf(key)).IsValid() && !src.MapIndex(reflect.ValueOf(key)).IsValid() {
+			return key
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The line types read the values of a data line by their position in the met_header_columns table of the version.
+When the header line of a file names the columns of its lines, the values are mapped to the columns of the line
+type by name instead, so a file that has an extra column, is missing a column or writes the columns in another
+order is not silently misaligned. The header section is always mapped by name. The data section is mapped by
+name if the header line has the data columns of the line type, i.e. the header of a _cnt.txt file, and by
+position if it does not, i.e. the header of a .stat file that ends at LINE_TYPE, or if the line type has repeated
+sequences, whose columns are only known from the line. A missing column is "NA".
+
+The columns of the file that are not columns of the line type, and the columns of the line type that are not in
+the file, are reported as a *ColumnMismatchError. The ColumnMapping policy of a Parser decides what is done with it.
+*/
+type ColumnMapping int
+
+const (
+	// ColumnsByName maps the values by the column names of the header line and warns about a column mismatch once
+	// for each header line and line type. This is the default.
+	ColumnsByName ColumnMapping = iota
+	// ColumnsStrict maps the values by the column names of the header line and rejects the lines of a header line that
+	// does not have the columns of the line type with a ColumnMismatchError.
+	ColumnsStrict
+	// ColumnsByPosition reads the values by their position, like the parser always did.
+	ColumnsByPosition
+)
+
+// WithColumnMapping sets how the values of a data line are mapped to the columns of its line type, the default is ColumnsByName.
+func WithColumnMapping(policy ColumnMapping) Option {
+	return func(p *Parser) {
+		p.columnMapping = policy
+	}
+}
+
+// ColumnMismatchError reports the columns of a header line that do not match the columns of a line type.
+type ColumnMismatchError struct {
+	FileName     string
+	FileLineType string
+	Version      string   // the parser version of the line type columns
+	Unknown      []string // the columns of the header line that are not columns of the line type
+	Missing      []string // the columns of the line type that are not in the header line
+}
+
+func (e *ColumnMismatchError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v: the header columns of %s do not match the %s columns of version %s", ErrColumnMismatch, e.FileName, e.FileLineType, e.Version)
+	if len(e.Unknown) > 0 {
+		fmt.Fprintf(&b, ", unknown columns %s", strings.Join(e.Unknown, " "))
+	}
+	if len(e.Missing) > 0 {
+		fmt.Fprintf(&b, ", missing columns %s", strings.Join(e.Missing, " "))
+	}
+	return b.String()
+}
+
+func (e *ColumnMismatchError) Unwrap() error {
+	return ErrColumnMismatch
+}
+
+// Column returns the first unknown or missing column.
+func (e *ColumnMismatchError) Column() string {
+	if len(e.Unknown) > 0 {
+		return e.Unknown[0]
+	}
+	if len(e.Missing) > 0 {
+		return e.Missing[0]
+	}
+	return ""
+}
+
+/*
+userColumns are the columns of the line types whose name in a file is the value of a user setting, i.e. the
+INTENSITY_USER column of MODE is written as INTENSITY_95 for the 95th percentile.
+*/
+var userColumns = map[string]*regexp.Regexp{
+	"INTENSITY_USER": regexp.MustCompile(`^INTENSITY_[0-9]+$`),
+}
+
+// columnMappingKey identifies the lines that a column mapping applies to.
+type columnMappingKey struct {
+	version      string
+	fileLineType string
+	headerLine   string
+}
+
+/*
+columnMappingResult maps the values of the lines of a columnMappingKey to the columns of the line type. A nil
+columns means that the header line has the columns of the line type and the line is used as it is.
+*/
+type columnMappingResult struct {
+	columns       []string // the columns of the line type that are mapped by name
+	headerColumns []string // the header section of columns
+	indexes       []int    // the index of the value of each column in the data line, -1 for a missing column
+	positional    int      // the index of the first value of a data section that is read by position, -1 if there is none
+	mismatch      *ColumnMismatchError
+}
+
+/*
+mapColumns returns the header and the data line that a data line is parsed with, see ColumnMapping. The mapping of a
+header line is only worked out once for each version and line type, and a mismatch is only reported once.
+*/
+func (p *Parser) mapColumns(header *util.CompiledHeader, parserVersion string, dataLine string) (*util.CompiledHeader, string, error) {
+	if p.columnMapping == ColumnsByPosition {
+		return header, dataLine, nil
+	}
+	fileLineType := header.LineType(dataLine)
+	set, err := p.lineTypeSetFor(parserVersion, header, fileLineType)
+	if err != nil {
+		// the error is returned when the line is parsed
+		return header, dataLine, nil
+	}
+	key := columnMappingKey{version: set.Version(), fileLineType: fileLineType, headerLine: header.HeaderLine}
+	cached, ok := p.columnMappings.Load(key)
+	if !ok {
+		var loaded bool
+		cached, loaded = p.columnMappings.LoadOrStore(key, newColumnMapping(header, set.Version(), fileLineType))
+		mismatch := cached.(columnMappingResult).mismatch
+		if !loaded && mismatch != nil && p.columnMapping == ColumnsByName && p.warningHandler != nil {
+			p.warningHandler(mismatch)
+		}
+	}
+	result := cached.(columnMappingResult)
+	if result.mismatch != nil && p.columnMapping == ColumnsStrict {
+		return header, dataLine, result.mismatch
+	}
+	allData := strings.Fields(dataLine)
+	if result.columns == nil || len(allData) < len(header.HeaderStringFields) {
+		// a truncated header section is reported when the line is parsed
+		return header, dataLine, nil
+	}
+	values := make([]string, 0, len(allData)+len(result.columns))
+	for _, index := range result.indexes {
+		if index < 0 || index >= len(allData) {
+			values = append(values, "NA")
+		} else {
+			values = append(values, allData[index])
+		}
+	}
+	columns := result.columns
+	if result.positional >= 0 {
+		values = append(values, allData[result.positional:]...)
+		columns = slices.Concat(columns, header.Fields[min(result.positional, len(header.Fields)):])
+	}
+	mapped := *header
+	mapped.HeaderLine = strings.Join(columns, " ")
+	mapped.Fields = columns
+	mapped.HeaderStringFields = result.headerColumns
+	mapped.DescIndex = slices.Index(result.headerColumns, "DESC")
+	return &mapped, strings.Join(values, " "), nil
+}
+
+// newColumnMapping works out the mapping of the values of the lines of a header line to the columns of a line type.
+func newColumnMapping(header *util.CompiledHeader, version string, fileLineType string) columnMappingResult {
+	headerColumns, dataColumns, err := util.LineTypeColumns(version, fileLineType)
+	if err != nil {
+		// an unknown line type is reported when the line is parsed
+		return columnMappingResult{positional: -1}
+	}
+	fileHeaderColumns := header.HeaderStringFields
+	fileDataColumns := header.Fields[min(len(fileHeaderColumns), len(header.Fields)):]
+	columns := headerColumns
+	fileColumns := fileHeaderColumns
+	positional := len(fileHeaderColumns)
+	if len(fileDataColumns) > 0 && !hasRepeatedSequence(dataColumns) && slices.ContainsFunc(fileDataColumns, func(column string) bool {
+		return slices.Contains(dataColumns, column)
+	}) {
+		// the header line has the data columns of the line type
+		columns = slices.Concat(headerColumns, dataColumns)
+		fileColumns = header.Fields
+		positional = -1
+	}
+	if slices.Equal(columns, fileColumns) {
+		return columnMappingResult{positional: -1}
+	}
+	result := columnMappingResult{
+		columns:       columns,
+		headerColumns: headerColumns,
+		indexes:       make([]int, len(columns)),
+		positional:    positional,
+	}
+	mismatch := &ColumnMismatchError{FileName: header.FileName, FileLineType: fileLineType, Version: version}
+	matched := make([]bool, len(fileColumns))
+	for i, column := range columns {
+		result.indexes[i] = slices.Index(fileColumns, column)
+		if pattern, ok := userColumns[column]; ok && result.indexes[i] < 0 {
+			result.indexes[i] = slices.IndexFunc(fileColumns, func(fileColumn string) bool {
+				return pattern.MatchString(fileColumn) && !slices.Contains(columns, fileColumn)
+			})
+		}
+		if result.indexes[i] < 0 {
+			mismatch.Missing = append(mismatch.Missing, column)
+		} else {
+			matched[result.indexes[i]] = true
+		}
+	}
+	for i, column := range fileColumns {
+		if !matched[i] {
+			mismatch.Unknown = append(mismatch.Unknown, column)
+		}
+	}
+	if len(mismatch.Missing) > 0 || len(mismatch.Unknown) > 0 {
+		result.mismatch = mismatch
+	}
+	return result
+}
+
+// hasRepeatedSequence returns true if the data columns of a line type have a repeated sequence i.e. (N_THRESH) THRESH_[0-9]*.
+func hasRepeatedSequence(dataColumns []string) bool {
+	return slices.ContainsFunc(dataColumns, isRepeatedColumn)
+}
+
+// isRepeatedColumn returns true if a column of the met_header_columns table is a column of a repeated sequence, i.e. (N_THRESH) or THRESH_[0-9]*.
+func isRepeatedColumn(column string) bool {
+	return strings.HasPrefix(column, "(") || strings.Contains(column, "[0-9]*")
+}
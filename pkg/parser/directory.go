@@ -0,0 +1,281 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+/*
+ParseDirectory parses every MET output file below a directory. The files are fanned out to a pool of workers
+that each parse a whole file into their own MemoryStore with ParseFileToStore, so the parsing uses every core.
+The per-file documents are then merged by document id into the DocumentStore in lexical path order, which is
+the same order that filepath.WalkDir visits the files, so the merged documents are the same no matter how many
+workers there are or which worker finishes first. When two files have data for the same document id and dataKey
+the data is merged by the CollisionPolicy of the Parser, by default the data from the file that sorts later wins,
+just like it would if the files were parsed one after another.
+
+Only the merge uses the DocumentStore, so existing documents are looked up (i.e. by the getExternalDocForId of
+a NewCallbackStore) once per document id and never concurrently. The rejected lines are passed to the function of
+WithDeadLetter by the workers as they parse the files, one line at a time but not in path order, so that function
+does not have to be safe for concurrent use.
+*/
+
+// DirectorySummary describes what happened while parsing a directory.
+type DirectorySummary struct {
+	Files        []FileSummary  // the summaries of the files that were parsed, in path order
+	SkippedFiles int            // number of files that were excluded or skipped (i.e. .swp and .DS_Store files)
+	FileErrors   []error        // errors for files that could not be parsed at all, in path order
+	DataLines    int            // total number of data lines that were parsed
+	ErrorLines   int            // total number of data lines that could not be parsed
+	Collisions   map[string]int // data entries per fileLineType whose document id and data key were already there, in a file or in an earlier file
+	Duplicates   map[string]int // the collisions per fileLineType whose data was the same
+	Documents    int            // number of documents in the merged document map
+}
+
+/*
+WithWorkers sets the number of files that ParseDirectory parses at the same time. The default is runtime.NumCPU().
+*/
+func WithWorkers(workers int) ReadOption {
+	return func(c *readConfig) {
+		c.workers = workers
+	}
+}
+
+/*
+WithQueueSize sets how many files ParseDirectory lets wait for a worker. The directory walk blocks when the
+queue is full, so that a very large tree is never held in memory, and at most queue size plus workers files
+are parsed before they are merged. The default is twice the number of workers.
+*/
+func WithQueueSize(size int) ReadOption {
+	return func(c *readConfig) {
+		c.queueSize = size
+	}
+}
+
+/*
+WithInclude limits ParseDirectory to the files that match at least one of the filepath.Match patterns.
+A pattern is matched against both the file name and the path relative to the directory, i.e. "*.stat" or "grid_stat/*.stat".
+*/
+func WithInclude(patterns ...string) ReadOption {
+	return func(c *readConfig) {
+		c.include = append(c.include, patterns...)
+	}
+}
+
+/*
+WithExclude makes ParseDirectory skip the files that match any of the filepath.Match patterns, see WithInclude.
+Excluded directories are not walked at all.
+*/
+func WithExclude(patterns ...string) ReadOption {
+	return func(c *readConfig) {
+		c.exclude = append(c.exclude, patterns...)
+	}
+}
+
+// matchAny returns true if either the name or the relative path matches one of the patterns.
+func matchAny(patterns []string, name string, relPath string) (bool, error) {
+	for _, pattern := range patterns {
+		for _, s := range []string{name, relPath} {
+			matched, err := filepath.Match(pattern, s)
+			if err != nil {
+				return false, fmt.Errorf("bad pattern %s: %w", pattern, err)
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// directoryJob is a file for a worker to parse, the index is the position of the file in the walk.
+type directoryJob struct {
+	index int
+	path  string
+}
+
+// directoryResult is the parsed documents of one file.
+type directoryResult struct {
+	index     int
+	path      string
+	docs      map[string]interface{}
+	lineTypes map[string]string // the fileLineType of each document id
+	summary   FileSummary
+	err       error
+}
+
+// ParseDirectory parses a directory with the default Parser, see Parser.ParseDirectory.
+func ParseDirectory(root string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, DirectorySummary, error) {
+	return defaultParser.ParseDirectory(root, dataSetName, docPtr, getExternalDocForId, opts...)
+}
+
+// ParseDirectoryToStore parses a directory with the default Parser, see Parser.ParseDirectoryToStore.
+func ParseDirectoryToStore(root string, dataSetName string, store DocumentStore, opts ...ReadOption) (DirectorySummary, error) {
+	return defaultParser.ParseDirectoryToStore(root, dataSetName, store, opts...)
+}
+
+/*
+ParseDirectory parses every file below root into the documents in docPtr. The options are the ReadOptions of
+ParseFile (i.e. WithDeadLetterWriter) along with WithWorkers, WithQueueSize, WithInclude and WithExclude.
+Files that fail to parse are recorded in the returned DirectorySummary and do not stop the other files.
+An error is only returned for a bad dataSetName, a bad pattern or if the directory cannot be walked.
+*/
+func (p *Parser) ParseDirectory(root string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, DirectorySummary, error) {
+	store := newMemoryStoreForDocPtr(docPtr)
+	summary, err := p.ParseDirectoryToStore(root, dataSetName, NewCallbackStore(store, getExternalDocForId), opts...)
+	return store.Documents(), summary, err
+}
+
+/*
+ParseDirectoryToStore parses every file below root like ParseDirectory, but merges the documents into a DocumentStore.
+*/
+func (p *Parser) ParseDirectoryToStore(root string, dataSetName string, store DocumentStore, opts ...ReadOption) (DirectorySummary, error) {
+	summary := DirectorySummary{Collisions: make(map[string]int), Duplicates: make(map[string]int)}
+	var config readConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if err := p.checkDataSetName(dataSetName); err != nil {
+		return summary, err
+	}
+	workers := config.workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	queueSize := config.queueSize
+	if queueSize <= 0 {
+		queueSize = 2 * workers
+	}
+	if deadLetter := config.deadLetter; deadLetter != nil {
+		// the workers reject lines at the same time, the dead letter function is called one line at a time
+		var mu sync.Mutex
+		opts = slices.Concat(opts, []ReadOption{WithDeadLetter(func(lineErr *LineError) {
+			mu.Lock()
+			defer mu.Unlock()
+			deadLetter(lineErr)
+		})})
+	}
+
+	jobs := make(chan directoryJob, queueSize)
+	results := make(chan directoryResult, workers)
+	// a slot is held by every file from when it is queued until it is merged, this bounds the number of parsed
+	// files that are waiting to be merged when a file that sorts earlier takes a long time to parse
+	slots := make(chan struct{}, queueSize+workers)
+	var skipped int
+	var walkErr error
+	// walk the directory and queue the files - the walk blocks while the queue is full
+	go func() {
+		defer close(jobs)
+		index := 0
+		walkErr = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			relPath, _ := filepath.Rel(root, path)
+			excluded, err := matchAny(config.exclude, d.Name(), relPath)
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if excluded && path != root {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			included := len(config.include) == 0
+			if !included {
+				if included, err = matchAny(config.include, d.Name(), relPath); err != nil {
+					return err
+				}
+			}
+			if excluded || !included || checkFileName(d.Name()) != nil {
+				skipped++
+				return nil
+			}
+			slots <- struct{}{}
+			jobs <- directoryJob{index: index, path: path}
+			index++
+			return nil
+		})
+	}()
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				fileStore := NewMemoryStore(nil)
+				lineTypes := make(map[string]string)
+				fileSummary, err := p.ParseFileToStore(job.path, dataSetName, fileStore, slices.Concat(opts, []ReadOption{withDocLineTypes(lineTypes)})...)
+				results <- directoryResult{index: job.index, path: job.path, docs: fileStore.Documents(), lineTypes: lineTypes, summary: fileSummary, err: err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// merge the results in walk order, holding back the results of files that finished early
+	pending := make(map[int]directoryResult)
+	next := 0
+	for result := range results {
+		pending[result.index] = result
+		for {
+			result, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			summary.addFile(result)
+			for id, doc := range result.docs {
+				// the data of a file that is already in the store is merged by the CollisionPolicy
+				collisions, err := p.mergeDocument(store, id, result.lineTypes[id], doc.(map[string]interface{}))
+				addCollisions(summary.Collisions, summary.Duplicates, result.lineTypes[id], collisions)
+				if err != nil {
+					summary.FileErrors = append(summary.FileErrors, fmt.Errorf("%s: %w", result.path, err))
+				}
+			}
+			<-slots
+		}
+	}
+	summary.SkippedFiles += skipped
+	summary.Documents = store.Len()
+	if walkErr != nil {
+		return summary, fmt.Errorf("error walking directory %s: %w", root, walkErr)
+	}
+	return summary, nil
+}
+
+// addFile adds the summary of one parsed file to the directory summary.
+func (s *DirectorySummary) addFile(result directoryResult) {
+	if errors.Is(result.err, ErrSkippedFile) {
+		s.SkippedFiles++
+		return
+	}
+	if result.err != nil {
+		s.FileErrors = append(s.FileErrors, fmt.Errorf("%s: %w", result.path, result.err))
+	}
+	s.Files = append(s.Files, result.summary)
+	s.DataLines += result.summary.DataLines
+	s.ErrorLines += result.summary.ErrorLines
+	for fileLineType, n := range result.summary.Collisions {
+		s.Collisions[fileLineType] += n
+	}
+	for fileLineType, n := range result.summary.Duplicates {
+		s.Duplicates[fileLineType] += n
+	}
+}
+
+// withDocLineTypes records the fileLineType of each document id of a file in lineTypes.
+func withDocLineTypes(lineTypes map[string]string) ReadOption {
+	return func(c *readConfig) {
+		c.docLineTypes = lineTypes
+	}
+}
@@ -0,0 +1,202 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+A Document is a typed view of a parsed document. H is the generated header struct and D is the generated
+data struct of the line type, i.e.
+
+	doc, err := parser.GetDocument[v12_0.STAT_CNT_header, v12_0.STAT_CNT](store, id)
+	fmt.Println(*doc.Header.MODEL, *doc.Data["120000"].FBAR)
+
+The header fields are pointers and a missing header value is nil, just like the numeric data fields.
+A Document marshals to the same JSON as the map[string]interface{} document that it was made from, with the
+metadata, the dataSetName and the header fields at the top level and the data entries in the "data" section.
+The missing header values are left out of the JSON output, which is the MissingOmit policy.
+
+A Document is only available for the documents of the MissingOmit policy. The MissingNull and MissingSentinel
+policies write the missing values into a map[string]interface{} data section, and a sentinel cannot be told apart
+from a real value once it is in a typed field, so NewDocument returns an error for their documents.
+*/
+type Document[H any, D any] struct {
+	Metadata    util.VxMetadata
+	DataSetName string
+	Header      H
+	Data        map[string]D
+}
+
+// documentKeys are the top level keys of a document that are not header fields.
+var documentKeys = []string{"id", "subset", "type", "subtype", "dataSetName", "data"}
+
+/*
+NewDocument returns the typed view of a document that was created by the parser, or read back from the JSON output.
+The data section is shared with doc if it is already a map[string]D, otherwise (i.e. for a document from
+getExternalDocForId or one that was read back from the JSON output) it is converted with its JSON representation.
+An error wrapping ErrDocumentType is returned if the document has data of a different line type, or if it was
+parsed with the MissingNull or MissingSentinel policy, which is when a data entry has a value for a field that it
+records as missing.
+*/
+func NewDocument[H any, D any](doc map[string]interface{}) (*Document[H, D], error) {
+	var document Document[H, D]
+	document.Metadata = util.VxMetadata{
+		ID:      stringValue(doc["id"]),
+		Subset:  stringValue(doc["subset"]),
+		Type:    stringValue(doc["type"]),
+		SubType: stringValue(doc["subtype"]),
+	}
+	document.DataSetName = stringValue(doc["dataSetName"])
+	header := make(map[string]interface{}, len(doc))
+	for key, value := range doc {
+		if !slices.Contains(documentKeys, key) {
+			header[key] = value
+		}
+	}
+	// the header of the MissingSentinel policy does not convert, the data tells the policy
+	if field := missingPolicyField(doc["data"]); field != "" {
+		return nil, fmt.Errorf("%w: data of %s has a value for the missing field %s, a Document needs the MissingOmit policy", ErrDocumentType, document.Metadata.ID, field)
+	}
+	if err := convertJson(header, &document.Header); err != nil {
+		return nil, fmt.Errorf("%w: header of %s: %w", ErrDocumentType, document.Metadata.ID, err)
+	}
+	switch data := doc["data"].(type) {
+	case map[string]D:
+		document.Data = data
+	case nil:
+		document.Data = make(map[string]D)
+	default:
+		dataType := reflect.TypeOf(data)
+		if dataType.Kind() != reflect.Map || dataType.Elem().Kind() == reflect.Struct {
+			// the data of another line type
+			return nil, fmt.Errorf("%w: data of %s is a %s", ErrDocumentType, document.Metadata.ID, dataType)
+		}
+		if err := convertJson(data, &document.Data); err != nil {
+			return nil, fmt.Errorf("%w: data of %s: %w", ErrDocumentType, document.Metadata.ID, err)
+		}
+	}
+	return &document, nil
+}
+
+/*
+GetDocument returns the typed view of the document for the id in a DocumentStore, see NewDocument.
+*/
+func GetDocument[H any, D any](store DocumentStore, id string) (*Document[H, D], error) {
+	doc, err := store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return NewDocument[H, D](doc)
+}
+
+/*
+Map returns the document as a map[string]interface{}, the representation that the parser and the DocumentStore use.
+The data section of the map is the Data map of the Document.
+*/
+func (d *Document[H, D]) Map() (map[string]interface{}, error) {
+	doc, err := getMetaDataMap(d.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	doc["dataSetName"] = d.DataSetName
+	header := reflect.ValueOf(d.Header)
+	if header.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: header is a %s", ErrDocumentType, header.Type())
+	}
+	for _, field := range getStructFields(header.Type()).fields {
+		value := header.Field(field.index)
+		if field.kind == reflect.Pointer {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+		doc[field.name] = value.Interface()
+	}
+	doc["data"] = d.Data
+	return doc, nil
+}
+
+// MarshalJSON marshals the Document in the same shape as the map[string]interface{} document.
+func (d Document[H, D]) MarshalJSON() ([]byte, error) {
+	doc, err := d.Map()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON reads a document from the JSON output, i.e. from ReadJsonFromGzipFile.
+func (d *Document[H, D]) UnmarshalJSON(data []byte) error {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	document, err := NewDocument[H, D](doc)
+	if err != nil {
+		return err
+	}
+	*d = *document
+	return nil
+}
+
+/*
+missingPolicyField returns the first field of a data section that an entry records as missing but has a value
+for, null or a sentinel, i.e. "fbar" or "thresh.THRESH_1" for a sequence value. It returns "" for the data of the
+MissingOmit policy, which leaves the missing values out.
+*/
+func missingPolicyField(data interface{}) string {
+	entries, ok := data.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for _, key := range slices.Sorted(maps.Keys(entries)) {
+		entry, ok := entries[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var missing []string
+		switch names := entry["missing"].(type) {
+		case []string:
+			missing = names
+		case []interface{}:
+			// read back from the JSON output
+			for _, name := range names {
+				missing = append(missing, stringValue(name))
+			}
+		}
+		for _, name := range missing {
+			_, present := entry[name]
+			if sequence, sequenceKey, ok := strings.Cut(name, "."); ok {
+				values, _ := entry[sequence].(map[string]interface{})
+				_, present = values[sequenceKey]
+			}
+			if present {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// stringValue returns the value if it is a string, otherwise "".
+func stringValue(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
+
+// convertJson converts a value to the type of dst through its JSON representation.
+func convertJson(value interface{}, dst interface{}) error {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonBytes, dst)
+}
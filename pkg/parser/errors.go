@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The errors returned by ParseLine, ParseReader and ParseFile wrap one of these sentinel errors, so callers
+can use errors.Is to route different kinds of failures. Errors for a particular data line are returned as
+a *LineError which carries the file name, line number, line type, MET version and the offending column, if they are known.
+*/
+var (
+	ErrInvalidDataSetName  = errors.New("invalid dataSetName")
+	ErrInvalidVersion      = errors.New("invalid MET version format")
+	ErrEmptyLine           = errors.New("empty line")
+	ErrMissingHeader       = errors.New("missing header line")
+	ErrSkippedFile         = errors.New("skipped file")
+	ErrDocNotFound         = errors.New(DOC_NOT_FOUND)
+	ErrPanic               = errors.New("recovered panic")
+	ErrDocumentType        = errors.New("document is not of the requested type")
+	ErrIncompatibleVersion = errors.New("incompatible MET version") // the header columns do not match the nearest supported version
+	ErrColumnMismatch      = errors.New("column mismatch")          // the header columns do not match the columns of the line type
+	ErrCollision           = errors.New("data key collision")       // the document already has data for the data key
+	ErrInvalidGrouping     = errors.New("invalid grouping")         // a grouping names a column that the line type does not have
+	ErrTruncatedLine       = util.ErrTruncatedLine
+	ErrUnknownLineType     = util.ErrUnknownLineType
+	ErrMissingDataKey      = util.ErrMissingDataKey
+	ErrUnsupportedVersion  = util.ErrUnsupportedVersion
+	ErrIdTooLong           = util.ErrIdTooLong
+)
+
+// LineError describes why a data line could not be parsed.
+type LineError struct {
+	FileName   string
+	LineNumber int    // 1 based line number in the file, 0 if it is not known i.e. for ParseLine
+	LineType   string // fileLineType e.g. STAT_CNT, if it could be determined
+	Version    string // parser version e.g. v12_0, if it could be determined
+	Column     string // the header column name of the offending column, if it could be determined
+	Line       string // the data line
+	Err        error
+}
+
+func (e *LineError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.FileName)
+	if e.LineNumber > 0 {
+		fmt.Fprintf(&b, " line %d", e.LineNumber)
+	}
+	if e.LineType != "" {
+		fmt.Fprintf(&b, " lineType %s", e.LineType)
+	}
+	if e.Version != "" {
+		fmt.Fprintf(&b, " version %s", e.Version)
+	}
+	if e.Column != "" {
+		fmt.Fprintf(&b, " column %s", e.Column)
+	}
+	b.WriteString(": ")
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError is a panic that was recovered while parsing a data line.
+type PanicError struct {
+	Value interface{} // the value that was passed to panic
+	Line  string      // the data line
+	Stack []byte      // the stack of the goroutine that panicked
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v: %v for data line %q\n%s", ErrPanic, e.Value, e.Line, e.Stack)
+}
+
+func (e *PanicError) Unwrap() error {
+	return ErrPanic
+}
+
+/*
+newLineError creates a LineError for a data line and determines the offending column from the kind of error.
+The header may be nil if the error happened before the header was compiled.
+*/
+func newLineError(header *util.CompiledHeader, fileName string, version string, lineType string, dataLine string, err error) *LineError {
+	lineErr := &LineError{FileName: fileName, Version: version, LineType: lineType, Line: dataLine, Err: err}
+	switch {
+	case errors.Is(err, ErrInvalidVersion), errors.Is(err, ErrUnsupportedVersion):
+		lineErr.Column = "VERSION"
+	case header == nil:
+	case errors.Is(err, ErrTruncatedLine):
+		// the first column that is missing from the data line
+		n := len(strings.Fields(dataLine))
+		if n < len(header.Fields) {
+			lineErr.Column = header.Fields[n]
+		}
+	case errors.Is(err, ErrUnknownLineType):
+		lineErr.Column = header.SeparatorField
+	case errors.Is(err, ErrMissingDataKey), errors.Is(err, ErrCollision):
+		entry, _ := util.GetDataKeyEntry(lineType)
+		lineErr.Column = strings.Join(entry.DataKey, ",")
+	case errors.Is(err, ErrColumnMismatch):
+		var mismatch *ColumnMismatchError
+		if errors.As(err, &mismatch) {
+			lineErr.Column = mismatch.Column()
+		}
+	case errors.Is(err, ErrInvalidGrouping):
+		var grouping *GroupingError
+		if errors.As(err, &grouping) {
+			lineErr.Column = grouping.Column
+		}
+	}
+	return lineErr
+}
+
+// isDocNotFound accepts either the ErrDocNotFound error or any error with the DOC_NOT_FOUND message prefix.
+func isDocNotFound(err error) bool {
+	return errors.Is(err, ErrDocNotFound) || strings.HasPrefix(err.Error(), DOC_NOT_FOUND)
+}
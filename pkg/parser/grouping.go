@@ -0,0 +1,264 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/engine"
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+TCGrouping is how the lines of the TC line types (TCST_TCMPR, TCST_TCDIAG and TCST_PROBRIRW) are grouped into
+documents. Their INIT column is moved from the header to the data, see util.DataKeyMap, so the document id does not
+tell the cycles of a storm apart. Keyed by LEAD alone, the line of one cycle replaces the line of another cycle with
+the same lead whenever the rest of the header is the same, i.e. when VALID is missing, so by default the data is
+keyed by INIT and LEAD.
+*/
+type TCGrouping int
+
+const (
+	// TCTrackByInitAndLead has one document per storm track, the data is keyed by INIT (as an epoch) and LEAD,
+	// i.e. 1730678400_240000. This is the default.
+	TCTrackByInitAndLead TCGrouping = iota
+	// TCCyclePerDocument has one document per storm track and INIT, INIT is part of the id and the data is keyed by LEAD.
+	TCCyclePerDocument
+	// TCTrackByLead has one document per storm track keyed by LEAD, a later cycle replaces the leads of an earlier one.
+	TCTrackByLead
+)
+
+// WithTCGrouping sets how the lines of the TC line types are grouped into documents, the default is TCTrackByInitAndLead.
+func WithTCGrouping(grouping TCGrouping) Option {
+	return func(p *Parser) {
+		p.tcGrouping = grouping
+	}
+}
+
+/*
+groupByInit returns the data key and the header data of the id of a line whose INIT column is moved to the data,
+see TCGrouping. Other lines are returned as they are.
+*/
+func (p *Parser) groupByInit(header *util.CompiledHeader, fileLineType string, dataLine string, headerData []string, dataKey string) (string, []string) {
+	if _, ok := p.groupings[fileLineType]; ok {
+		// a line type with a grouping of its own is grouped the way it says
+		return dataKey, headerData
+	}
+	dataKeyEntry, _ := util.GetDataKeyEntry(fileLineType)
+	if p.tcGrouping == TCTrackByLead || !slices.Contains(dataKeyEntry.HeaderDisallow, "INIT") {
+		return dataKey, headerData
+	}
+	initIndex := slices.Index(header.HeaderStringFields, "INIT")
+	fields := strings.Fields(dataLine)
+	if initIndex < 0 || initIndex >= len(headerData) || initIndex >= len(fields) || fields[initIndex] == "NA" {
+		return dataKey, headerData
+	}
+	init, _ := util.GetHeaderValue(header.Fields, fields, "INIT")
+	if p.tcGrouping == TCCyclePerDocument {
+		idHeaderData := slices.Clone(headerData)
+		idHeaderData[initIndex] = init
+		return dataKey, idHeaderData
+	}
+	return init + "_" + dataKey, headerData
+}
+
+/*
+WithGrouping groups the lines of a line type (a fileLineType i.e. STAT_CNT) into documents by a DataKeyMap entry of
+its own instead of the entry in util.DataKeyMap. The IdColumns of the entry are the header columns of the document
+id, the DataKey columns form the key of the data in the document and the HeaderDisallow columns are moved from the
+header to the data. The header columns that are not in the id are left out of the document header too, the data key
+is the only place where the values of the DataKey columns are kept. The entry replaces the one in util.DataKeyMap
+everywhere, so a column that is a data key in util.DataKeyMap, i.e. FCST_LEAD, is a header field if it is not a data
+key of the grouping, and the INIT of the TC line types stays in the header unless it is a HeaderDisallow column of the
+grouping. The lines are parsed by the engine package with a layout of the grouping, see engine.LineTypeSet.Grouped,
+and the TCGrouping does not apply to them. For instance, one document per valid time keyed by lead, one document per
+lead keyed by VX_MASK, one document per cycle and OBTYPE in the data:
+
+	parser.WithGrouping("STAT_CNT", util.DataKeyEntry{IdColumns: []string{"VERSION", "MODEL", "FCST_VALID_BEG", "FCST_VAR", "VX_MASK", "LINE_TYPE"}, DataKey: []string{"FCST_LEAD"}})
+	parser.WithGrouping("STAT_CNT", util.DataKeyEntry{DataKey: []string{"VX_MASK"}})
+	parser.WithGrouping("TCST_TCMPR", util.DataKeyEntry{DataKey: []string{"LEAD"}})
+	parser.WithGrouping("STAT_CNT", util.DataKeyEntry{DataKey: []string{"FCST_LEAD"}, HeaderDisallow: []string{"OBTYPE"}})
+
+The columns are checked against the columns of the line type in the version that a line is parsed with, and the
+lines of a grouping that names a column that the line type does not have are rejected with a GroupingError. Use
+ValidateGroupings to check the groupings before parsing.
+*/
+func WithGrouping(fileLineType string, entry util.DataKeyEntry) Option {
+	return func(p *Parser) {
+		if p.groupings == nil {
+			p.groupings = make(map[string]util.DataKeyEntry)
+		}
+		entry.DataKey = slices.Clone(entry.DataKey)
+		entry.HeaderDisallow = slices.Clone(entry.HeaderDisallow)
+		entry.IdColumns = slices.Clone(entry.IdColumns)
+		p.groupings[fileLineType] = entry
+	}
+}
+
+// GroupingError reports a column of a grouping that is not a column of its line type, see WithGrouping.
+type GroupingError struct {
+	FileLineType string
+	Version      string // the parser version of the line type columns
+	Column       string // the column that is not a column of the line type, "" if the grouping has no data key
+	Header       bool   // the column is an id or HeaderDisallow column, which has to be a header column
+}
+
+func (e *GroupingError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("%v: the grouping of %s has no data key", ErrInvalidGrouping, e.FileLineType)
+	}
+	kind := "column"
+	if e.Header {
+		kind = "header column"
+	}
+	return fmt.Sprintf("%v: %s is not a %s of %s in version %s", ErrInvalidGrouping, e.Column, kind, e.FileLineType, e.Version)
+}
+
+func (e *GroupingError) Unwrap() error {
+	return ErrInvalidGrouping
+}
+
+/*
+ValidateGroupings checks the columns of the groupings of a Parser, see WithGrouping, against the columns of their line
+types in every supported version that has the line type. It returns the GroupingErrors joined together, and an
+ErrInvalidGrouping error for a line type that is in none of the versions.
+*/
+func (p *Parser) ValidateGroupings() error {
+	var errs []error
+	for _, fileLineType := range slices.Sorted(maps.Keys(p.groupings)) {
+		versions := SupportedVersions()
+		if util.IsCustomLineType(fileLineType) {
+			versions = []string{engine.CustomVersion}
+		}
+		found := false
+		for _, version := range versions {
+			err := validateGrouping(version, fileLineType, p.groupings[fileLineType])
+			if errors.Is(err, ErrUnknownLineType) {
+				continue
+			}
+			found = true
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Errorf("%w: %w %s", ErrInvalidGrouping, ErrUnknownLineType, fileLineType))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// groupingKey identifies the lines that a grouping check applies to.
+type groupingKey struct {
+	version      string
+	fileLineType string
+}
+
+/*
+checkGrouping returns a GroupingError if the grouping of a line type names a column that the line type does not have
+in the version that the line is parsed with. The check is only done once for each version and line type.
+*/
+func (p *Parser) checkGrouping(parserVersion string, header *util.CompiledHeader, fileLineType string) error {
+	entry, ok := p.groupings[fileLineType]
+	if !ok {
+		return nil
+	}
+	set, err := p.versionLineTypeSetFor(parserVersion, header, fileLineType)
+	if err != nil {
+		// the error is returned when the line is parsed
+		return nil
+	}
+	key := groupingKey{version: set.Version(), fileLineType: fileLineType}
+	cached, ok := p.groupingChecks.Load(key)
+	if !ok {
+		err := validateGrouping(set.Version(), fileLineType, entry)
+		if errors.Is(err, ErrUnknownLineType) {
+			// the unknown line type is reported when the line is parsed
+			err = nil
+		}
+		cached, _ = p.groupingChecks.LoadOrStore(key, err)
+	}
+	err, _ = cached.(error)
+	return err
+}
+
+// validateGrouping checks the columns of a grouping against the columns of a line type in a version.
+func validateGrouping(version string, fileLineType string, entry util.DataKeyEntry) error {
+	headerColumns, dataColumns, err := util.LineTypeColumns(version, fileLineType)
+	if err != nil {
+		return err
+	}
+	if len(entry.DataKey) == 0 {
+		return &GroupingError{FileLineType: fileLineType, Version: version}
+	}
+	// the data key can be a data column up to the first repeated sequence, see util.CompiledHeader.GetLineType
+	if index := slices.IndexFunc(dataColumns, isRepeatedColumn); index >= 0 {
+		dataColumns = dataColumns[:index]
+	}
+	for _, column := range entry.DataKey {
+		if !slices.Contains(headerColumns, column) && !slices.Contains(dataColumns, column) {
+			return &GroupingError{FileLineType: fileLineType, Version: version, Column: column}
+		}
+	}
+	for _, column := range slices.Concat(entry.IdColumns, entry.HeaderDisallow) {
+		if !slices.Contains(headerColumns, column) {
+			return &GroupingError{FileLineType: fileLineType, Version: version, Column: column, Header: true}
+		}
+	}
+	return nil
+}
+
+// groupedSet is the LineTypeSet of a line type with a grouping of its own in a version, or the error of building it.
+type groupedSet struct {
+	set util.LineTypeSet
+	err error
+}
+
+/*
+groupedLineTypeSet returns the LineTypeSet that the lines of a line type with a grouping of its own are parsed with in
+a version. The data of the generated linetypes packages only has fields for the HeaderDisallow columns of
+util.DataKeyMap, so the lines are parsed by the engine with a layout of the grouping, see engine.LineTypeSet.Grouped.
+The set is only built once for each version and line type.
+*/
+func (p *Parser) groupedLineTypeSet(version string, fileLineType string) (util.LineTypeSet, error) {
+	key := groupingKey{version: version, fileLineType: fileLineType}
+	if cached, ok := p.groupedSets.Load(key); ok {
+		result := cached.(groupedSet)
+		return result.set, result.err
+	}
+	var result groupedSet
+	set := engine.Custom()
+	if version != engine.CustomVersion {
+		set, result.err = engine.Get(version)
+	}
+	if result.err == nil {
+		if grouped, err := set.Grouped(fileLineType, p.groupings[fileLineType]); err != nil {
+			result.err = fmt.Errorf("%w: the grouping of %s: %w", ErrInvalidGrouping, fileLineType, err)
+		} else {
+			result.set = grouped
+		}
+	}
+	cached, _ := p.groupedSets.LoadOrStore(key, result)
+	result = cached.(groupedSet)
+	return result.set, result.err
+}
+
+// dataKeyEntry returns the DataKeyMap entry that the lines of a line type are grouped by.
+func (p *Parser) dataKeyEntry(fileLineType string) util.DataKeyEntry {
+	if entry, ok := p.groupings[fileLineType]; ok {
+		return entry
+	}
+	entry, _ := util.GetDataKeyEntry(fileLineType)
+	return entry
+}
+
+// newLineError is newLineError with the offending data key columns of the grouping of the line type.
+func (p *Parser) newLineError(header *util.CompiledHeader, fileName string, version string, lineType string, dataLine string, err error) *LineError {
+	lineErr := newLineError(header, fileName, version, lineType, dataLine, err)
+	if header != nil && (errors.Is(err, ErrMissingDataKey) || errors.Is(err, ErrCollision)) {
+		lineErr.Column = strings.Join(p.dataKeyEntry(lineType).DataKey, ",")
+	}
+	return lineErr
+}
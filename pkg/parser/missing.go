@@ -0,0 +1,219 @@
+package parser
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+/*
+The missing-value model
+
+A MET column is missing when its value is "NA", or when the data line is truncated. The generated code in
+pkg/linetypes represents every missing value the same way, as nil:
+
+	header fields       the header field of the document is nil
+	scalar data fields  the numeric fields of the data structs are pointers and a missing value is a nil pointer,
+	                    so a real 0 (i.e. a zero bias or zero misses) is not mistaken for a missing value.
+	                    A missing string field is ""
+	repeated sequences  the value in the sequence map (i.e. the THRESH_n values of a PCT line) is nil
+
+The parser then applies its MissingValues policy to the document, so that the JSON output has one consistent
+representation for all of them, and records the JSON names of the missing data fields of each data entry in
+its "missing" list. Missing sequence values are recorded as the sequence name and the key i.e. "thresh.THRESH_1".
+The typed Document view is only available for the MissingOmit policy, see Document.
+*/
+type MissingValues int
+
+const (
+	// MissingOmit leaves missing values out of the JSON output, this is the default.
+	MissingOmit MissingValues = iota
+	// MissingNull writes missing values as JSON null, so every column of the line type is in the output.
+	MissingNull
+	// MissingSentinel writes missing values as the sentinel value, see WithMissingSentinel.
+	MissingSentinel
+)
+
+// DefaultMissingSentinel is the sentinel value for the MissingSentinel policy, unless WithMissingSentinel sets another one.
+const DefaultMissingSentinel = -9999
+
+// WithMissingValues sets how missing values are written to JSON, the default is MissingOmit.
+func WithMissingValues(policy MissingValues) Option {
+	return func(p *Parser) {
+		p.missingValues = policy
+	}
+}
+
+/*
+WithMissingSentinel writes every missing value, header, data and sequence values alike, as the sentinel value
+i.e. -9999 or "NA". It sets the MissingSentinel policy.
+*/
+func WithMissingSentinel(sentinel interface{}) Option {
+	return func(p *Parser) {
+		p.missingValues = MissingSentinel
+		p.missingSentinel = sentinel
+	}
+}
+
+// jsonField is a field of a generated data struct and its JSON name.
+type jsonField struct {
+	index int
+	name  string
+	kind  reflect.Kind
+}
+
+// structFields are the JSON fields of a generated data struct and the index of its MISSING field (-1 if it has none).
+type structFields struct {
+	fields  []jsonField
+	missing int
+}
+
+// structFieldsCache caches the structFields of the generated data struct types.
+var structFieldsCache sync.Map
+
+// getStructFields returns the exported fields of a struct type that are marshaled to JSON.
+func getStructFields(t reflect.Type) structFields {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.(structFields)
+	}
+	result := structFields{missing: -1}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Name == "MISSING" && field.Type == reflect.TypeOf([]string(nil)) {
+			result.missing = i
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		result.fields = append(result.fields, jsonField{index: i, name: name, kind: field.Type.Kind()})
+	}
+	structFieldsCache.Store(t, result)
+	return result
+}
+
+// missingValue returns the value that a missing value is replaced with, for the MissingNull and MissingSentinel policies.
+func (p *Parser) missingValue() interface{} {
+	if p.missingValues == MissingSentinel {
+		return p.missingSentinel
+	}
+	return nil
+}
+
+/*
+applyMissingValues applies the MissingValues policy to a document that was created by GetDocForId,
+the missing header values are nil and the data section is a map of generated data structs.
+*/
+func (p *Parser) applyMissingValues(doc map[string]interface{}) {
+	for key, value := range doc {
+		if key == "data" || value != nil {
+			continue
+		}
+		if p.missingValues == MissingOmit {
+			delete(doc, key)
+		} else {
+			doc[key] = p.missingValue()
+		}
+	}
+	doc["data"] = p.applyMissingData(doc["data"])
+}
+
+/*
+applyMissingData records the missing fields of every entry of a data section, i.e. a map[string]STAT_CNT, and
+applies the MissingValues policy to it. With MissingOmit the data section keeps its type, the missing scalar values
+are left out by their omitempty tags and missing sequence values are removed from the sequence maps.
+With MissingNull or MissingSentinel the data section is converted to a map[string]interface{} of
+map[string]interface{} entries that have every field of the data struct. Any other data section is returned as it is.
+*/
+func (p *Parser) applyMissingData(data interface{}) interface{} {
+	dataValue := reflect.ValueOf(data)
+	if dataValue.Kind() != reflect.Map || dataValue.Type().Elem().Kind() != reflect.Struct {
+		return data
+	}
+	elemType := dataValue.Type().Elem()
+	sf := getStructFields(elemType)
+	var converted map[string]interface{}
+	if p.missingValues != MissingOmit {
+		converted = make(map[string]interface{}, dataValue.Len())
+	}
+	iter := dataValue.MapRange()
+	for iter.Next() {
+		// copy the entry so that it can be changed
+		elem := reflect.New(elemType).Elem()
+		elem.Set(iter.Value())
+		var missing []string
+		var entry map[string]interface{}
+		if converted != nil {
+			entry = make(map[string]interface{}, len(sf.fields)+1)
+		}
+		for _, field := range sf.fields {
+			value := elem.Field(field.index)
+			isMissing := false
+			switch field.kind {
+			case reflect.Pointer, reflect.Interface:
+				isMissing = value.IsNil()
+			case reflect.String:
+				isMissing = value.String() == ""
+			case reflect.Map:
+				if sequence, ok := value.Interface().(map[string]interface{}); ok {
+					missing = append(missing, p.applyMissingSequence(field.name, sequence)...)
+				}
+			}
+			if isMissing {
+				missing = append(missing, field.name)
+			}
+			if entry == nil {
+				continue
+			}
+			switch {
+			case isMissing:
+				entry[field.name] = p.missingValue()
+			case field.kind == reflect.Pointer:
+				entry[field.name] = value.Elem().Interface()
+			default:
+				entry[field.name] = value.Interface()
+			}
+		}
+		if sf.missing >= 0 {
+			elem.Field(sf.missing).Set(reflect.ValueOf(missing))
+		}
+		if entry == nil {
+			dataValue.SetMapIndex(iter.Key(), elem)
+			continue
+		}
+		if len(missing) > 0 {
+			entry["missing"] = missing
+		}
+		converted[iter.Key().String()] = entry
+	}
+	if converted != nil {
+		return converted
+	}
+	return data
+}
+
+// applyMissingSequence applies the MissingValues policy to the nil values of a sequence and returns their names, in key order.
+func (p *Parser) applyMissingSequence(name string, sequence map[string]interface{}) []string {
+	var missing []string
+	for key, value := range sequence {
+		if value != nil {
+			continue
+		}
+		missing = append(missing, name+"."+key)
+		if p.missingValues == MissingOmit {
+			delete(sequence, key)
+		} else {
+			sequence[key] = p.missingValue()
+		}
+	}
+	slices.Sort(missing)
+	return missing
+}
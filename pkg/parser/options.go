@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"sync"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+A Parser holds the policy that is used to build the documents, i.e. the subset, type and subtype of the
+document ids, the rules for the dataSetName, how much of the DESC field is used in the id, how missing values
+are written and which linetypes package parses the lines of a MET version. Apart from its policy a Parser only
+has caches of the version fallbacks, the column mappings and the grouping checks and a lock for the merges of the documents, which are safe for concurrent use, so one Parser can be used by many goroutines at once.
+The package level functions (ParseLine, ParseFile, ...) use a default Parser that has the same
+policy that the parser has always had:
+
+	id subset "MET", type "DD", subtype "MET"
+	dataSetName at most 10 characters
+	DESC truncated to 10 characters in the id
+	missing values left out of the JSON output
+	unknown MET versions parsed with the nearest older version if the header columns match
+	lines parsed with the generated linetypes packages
+	values mapped to the columns of the line type by the column names of the header line
+	TC lines keyed by INIT and LEAD in one document per storm track
+	lines grouped into documents by util.DataKeyMap
+	the data of a line replaces data with the same document id and data key
+*/
+type Parser struct {
+	subset               string
+	docType              string
+	subType              string
+	maxDataSetNameLength int                            // 0 means that the length is not limited
+	dataSetNameRule      func(dataSetName string) error // an extra check of the dataSetName, may be nil
+	descLength           int                            // 0 means that DESC is not truncated
+	missingValues        MissingValues                  // how missing values are written to JSON
+	missingSentinel      interface{}                    // the value of a missing value for the MissingSentinel policy
+	versionFallback      VersionFallback                // what to do with the versions that do not have a linetypes package
+	pinnedVersions       map[string]string              // versions that are always parsed with the linetypes package of another version
+	tableDriven          bool                           // parse with the engine package instead of the generated linetypes packages
+	columnMapping        ColumnMapping                  // how the values of a line are mapped to the columns of its line type
+	tcGrouping           TCGrouping                     // how the lines of the TC line types are grouped into documents
+	collisionPolicy      CollisionPolicy                // what is done with data whose document id and data key are already in the document
+	groupings            map[string]util.DataKeyEntry   // the DataKeyMap entries that replace those of util.DataKeyMap, by fileLineType
+	warningHandler       func(warning error)            // may be nil
+	fallbacks            *sync.Map                      // versionFallbackKey -> versionFallbackResult
+	columnMappings       *sync.Map                      // columnMappingKey -> columnMappingResult
+	collisionWarnings    *sync.Map                      // fileLineType -> true for the line types whose collisions were reported
+	groupingChecks       *sync.Map                      // groupingKey -> error
+	groupedSets          *sync.Map                      // groupingKey -> groupedSet
+	mergeMu              *sync.Mutex                    // serializes the collision checks and merges of the stores that have no lock of their own, see mergeLocker
+}
+
+// Option configures a Parser, see New.
+type Option func(*Parser)
+
+// defaultParser is used by the package level functions.
+var defaultParser = New()
+
+/*
+New returns a Parser with the default policy changed by the options, i.e.
+
+	p := parser.New(parser.WithSubset("METdev"), parser.WithDescLength(20))
+	doc, err := p.ParseLine(dataSetName, headerLine, dataLine, &doc, fileName, getExternalDocForId)
+*/
+func New(opts ...Option) *Parser {
+	p := &Parser{
+		subset:               "MET",
+		docType:              "DD",
+		subType:              "MET",
+		maxDataSetNameLength: 10,
+		descLength:           10,
+		missingSentinel:      DefaultMissingSentinel,
+		warningHandler:       logWarning,
+		fallbacks:            &sync.Map{},
+		columnMappings:       &sync.Map{},
+		collisionWarnings:    &sync.Map{},
+		groupingChecks:       &sync.Map{},
+		groupedSets:          &sync.Map{},
+		mergeMu:              &sync.Mutex{},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithSubset sets the subset of the document ids and metadata, the default is "MET".
+func WithSubset(subset string) Option {
+	return func(p *Parser) {
+		p.subset = subset
+	}
+}
+
+// WithType sets the type of the document ids and metadata, the default is "DD".
+func WithType(docType string) Option {
+	return func(p *Parser) {
+		p.docType = docType
+	}
+}
+
+// WithSubType sets the subtype of the document ids and metadata, the default is "MET".
+func WithSubType(subType string) Option {
+	return func(p *Parser) {
+		p.subType = subType
+	}
+}
+
+/*
+WithMaxDataSetNameLength sets the longest dataSetName that is accepted, the default is 10.
+A length of 0 means that the length is not limited, the id as a whole still has to fit in 250 characters.
+*/
+func WithMaxDataSetNameLength(length int) Option {
+	return func(p *Parser) {
+		p.maxDataSetNameLength = length
+	}
+}
+
+/*
+WithDataSetNameRule adds a check of the dataSetName, i.e. an allowed character set or a naming convention.
+An error from rule is wrapped in ErrInvalidDataSetName.
+*/
+func WithDataSetNameRule(rule func(dataSetName string) error) Option {
+	return func(p *Parser) {
+		p.dataSetNameRule = rule
+	}
+}
+
+// WithDescLength sets how many characters of the DESC field are used in the id, the default is 10. 0 means all of them.
+func WithDescLength(length int) Option {
+	return func(p *Parser) {
+		p.descLength = length
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"strings"
 
-	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_0"
-	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_1"
-	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_0"
-	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_1"
-	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v12_0"
+	// the generated line types of every supported MET version register themselves with util.RegisterLineTypeSet
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_0"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v10_1"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_0"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_1"
+	_ "github.com/NOAA-GSL/METstat2json/pkg/linetypes/v12_0"
 	"github.com/NOAA-GSL/METstat2json/pkg/util"
 )
 
@@ -53,6 +55,9 @@ The header line values (minus the dataKey fields) are used to derive the id, wit
 If the data section of of the document[id] is nil, a new data section is created. The data section is then populated
 with the data fields from the data line. If the data section is not nil, the data fields are added to the existing data map.
 
+The subset, type and subtype of the id, the dataSetName rules and the DESC truncation are the policy of a Parser,
+see New. ParseLine and the other package level functions use a Parser with the default policy.
+
 The parameter getExternalDocForId is a function pointer that is used to get an external document for a given id. This function
 is used to get a document from an external source, such as a database, that is indexed by the id. If the external document
 is not nil, it is added to the document map. If the external document is nil, a new document is created for the id.
@@ -61,156 +66,187 @@ is not nil, it is added to the document map. If the external document is nil, a
 const DOC_NOT_FOUND = "document not found"
 
 func getParserVersion(dataLine string) (string, error) {
-	metVersion := strings.ToLower(strings.Fields(dataLine)[0])
+	fields := strings.Fields(dataLine)
+	if len(fields) == 0 {
+		return "", ErrEmptyLine
+	}
+	metVersion := strings.ToLower(fields[0])
 	metVersionParts := strings.Split(metVersion, ".")
 	if len(metVersionParts) != 3 {
-		return "", fmt.Errorf("invalid MET version format: %s", metVersion)
+		return "", fmt.Errorf("%w: %s", ErrInvalidVersion, metVersion)
 	}
 	lineVersion := metVersionParts[0] + "_" + metVersionParts[1]
 	return lineVersion, nil
 }
 
+// ParseLine parses a data line with the default Parser, see Parser.ParseLine.
 func ParseLine(dataSetName string, headerLine string, dataLine string, docPtr *map[string]interface{}, fileName string, getExternalDocForId func(id string) (map[string]interface{}, error)) (map[string]interface{}, error) {
-	// recover from unexpected errors
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Printf("Recovered: %v for fileName %s\n", r, fileName)
-		}
-	}()
+	return defaultParser.ParseLine(dataSetName, headerLine, dataLine, docPtr, fileName, getExternalDocForId)
+}
 
-	if dataSetName == "" {
-		return *docPtr, fmt.Errorf("dataSetName is empty")
+// ParseLineToStore parses a data line with the default Parser, see Parser.ParseLineToStore.
+func ParseLineToStore(dataSetName string, headerLine string, dataLine string, fileName string, store DocumentStore) error {
+	return defaultParser.ParseLineToStore(dataSetName, headerLine, dataLine, fileName, store)
+}
+
+/*
+ParseLine parses a data line into the documents in docPtr, see the package documentation.
+*/
+func (p *Parser) ParseLine(dataSetName string, headerLine string, dataLine string, docPtr *map[string]interface{}, fileName string, getExternalDocForId func(id string) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	store := newMemoryStoreForDocPtr(docPtr)
+	err := p.ParseLineToStore(dataSetName, headerLine, dataLine, fileName, NewCallbackStore(store, getExternalDocForId))
+	return store.Documents(), err
+}
+
+/*
+ParseLineToStore parses a data line like ParseLine, but merges the data into the documents of a DocumentStore.
+Use NewCallbackStore to look up existing documents that are not in the store.
+*/
+func (p *Parser) ParseLineToStore(dataSetName string, headerLine string, dataLine string, fileName string, store DocumentStore) error {
+	if err := p.checkDataSetName(dataSetName); err != nil {
+		return err
+	}
+	// make sure we have the basename here
+	fileName = filepath.Base(fileName)
+	if headerLine == "" {
+		return newLineError(nil, fileName, "", "", dataLine, fmt.Errorf("empty header line: %w", ErrEmptyLine))
 	}
-	if len(dataSetName) > 10 {
-		return *docPtr, fmt.Errorf("dataSetName is too long - must be <= 10 characters")
+	if dataLine == "" {
+		return newLineError(nil, fileName, "", "", dataLine, fmt.Errorf("empty data line: %w", ErrEmptyLine))
 	}
 	// get line version e.g. V12.0.0 -> v12_0
 	parserVersion, _err := getParserVersion(dataLine)
 	if _err != nil {
-		return *docPtr, fmt.Errorf("error getting parser version from line %s: %w", dataLine, _err)
+		return newLineError(nil, fileName, "", "", dataLine, fmt.Errorf("error getting parser version from line %s: %w", dataLine, _err))
 	}
-	if headerLine == "" {
-		return *docPtr, fmt.Errorf("empty header line")
+	if err := checkFileName(fileName); err != nil {
+		return err
 	}
-	if dataLine == "" {
-		return *docPtr, fmt.Errorf("empty data line")
+	_, _, _, err := p.parseDataLine(dataSetName, util.CompileHeader(headerLine, fileName, parserVersion), parserVersion, dataLine, store)
+	return err
+}
+
+// checkDataSetName returns an ErrInvalidDataSetName error if the dataSetName cannot be used in a document id.
+func (p *Parser) checkDataSetName(dataSetName string) error {
+	if dataSetName == "" {
+		return fmt.Errorf("%w: dataSetName is empty", ErrInvalidDataSetName)
 	}
-	// make sure we have the basename here
-	fileName = filepath.Base(fileName)
-	filePathParts := strings.Split(fileName, ".")
-	fileType := strings.ToUpper(filePathParts[1])
+	if p.maxDataSetNameLength > 0 && len(dataSetName) > p.maxDataSetNameLength {
+		return fmt.Errorf("%w: %w: dataSetName is too long - must be <= %d characters", ErrInvalidDataSetName, ErrIdTooLong, p.maxDataSetNameLength)
+	}
+	if p.dataSetNameRule != nil {
+		if err := p.dataSetNameRule(dataSetName); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDataSetName, err)
+		}
+	}
+	return nil
+}
+
+/*
+checkFileName returns an ErrSkippedFile error for the files that should never be parsed, i.e. editor swap files
+and .DS_Store files.
+*/
+func checkFileName(fileName string) error {
+	filePathParts := strings.Split(filepath.Base(fileName), ".")
+	if len(filePathParts) < 2 {
+		return nil
+	}
+	// the last extension i.e. a swap file for grid_stat.stat is grid_stat.stat.swp
+	fileType := strings.ToUpper(filePathParts[len(filePathParts)-1])
 	if fileType == "SWP" {
 		// skip the swp files - might be editing a file and don't want to parse the .swp file
-		return *docPtr, fmt.Errorf("skipping swp file")
+		return fmt.Errorf("%w: skipping swp file", ErrSkippedFile)
 	}
 	if fileType == "DS_STORE" {
 		// skip the .DS_Store files
-		return *docPtr, fmt.Errorf("skipping .DS_Store file")
+		return fmt.Errorf("%w: skipping .DS_Store file", ErrSkippedFile)
 	}
+	return nil
+}
 
+/*
+parseDataLine does the work of ParseLine for a data line once the header has been compiled.
+It merges the data line into the store and returns the fileLineType and the id of the document that the line was added to.
+A panic while parsing the line (i.e. from a malformed line) is recovered and returned as a *LineError
+that wraps a *PanicError.
+*/
+func (p *Parser) parseDataLine(dataSetName string, header *util.CompiledHeader, parserVersion string, dataLine string, store DocumentStore) (fileLineType string, id string, collisions collisionCounts, err error) {
+	// recover from unexpected errors
+	defer func() {
+		if r := recover(); r != nil {
+			err = newLineError(header, header.FileName, parserVersion, fileLineType, dataLine, &PanicError{Value: r, Line: dataLine, Stack: debug.Stack()})
+		}
+	}()
+	fileName := header.FileName
 	// get the lineType
-	fileLineType, headerData, dataData, dataKey, descIndex, err := util.GetLineType(headerLine, dataLine, fileName, parserVersion)
+	var headerData, dataData []string
+	var dataKey string
+	var descIndex int
+	// the version that the line is parsed with is chosen by the columns of the header line of the file, not the mapped ones
+	fileHeader := header
+	// map the values of the line to the columns of its line type by the column names of the header line
+	header, dataLine, err = p.mapColumns(header, parserVersion, dataLine)
 	if err != nil {
-		// cannot process this line so return the docPtr as is - it is probably a truncated line
-		fmt.Println("Error getting line type: ", err)
-		return *docPtr, err
+		return header.LineType(dataLine), "", collisions, p.newLineError(header, fileName, parserVersion, header.LineType(dataLine), dataLine, err)
+	}
+	// the columns of a grouping of the Parser have to be columns of the line type
+	if err = p.checkGrouping(parserVersion, fileHeader, header.LineType(dataLine)); err != nil {
+		return header.LineType(dataLine), "", collisions, p.newLineError(header, fileName, parserVersion, header.LineType(dataLine), dataLine, err)
+	}
+	fileLineType, headerData, dataData, dataKey, descIndex, err = header.GetLineTypeWithDataKeys(dataLine, p.groupings)
+	if err != nil {
+		// cannot process this line - it is probably a truncated line
+		return fileLineType, "", collisions, p.newLineError(header, fileName, parserVersion, fileLineType, dataLine, err)
 	}
 	// if there are any disallowed fields in this linetype then add the disallowed data to the dataData array - in order
-	disallowedFields := util.DataKeyMap[fileLineType].HeaderDisallow
+	// these are the disallowed fields of the grouping of the line type, the data of its LineTypeSet has fields for them
+	disallowedFields := p.dataKeyEntry(fileLineType).HeaderDisallow
 	if len(disallowedFields) > 0 {
 		for _, disallowedField := range disallowedFields {
-			disAllowedFieldValue, err := util.GetHeaderValue(strings.Fields(headerLine), strings.Fields(dataLine), disallowedField)
 			// if there is an error getting the disallowed field, just append "" to the dataData array
-			if err != nil {
-				fmt.Println("Error getting disallowed field: ", err)
-			}
-			// if an err it appends ""
+			disAllowedFieldValue, _ := util.GetHeaderValue(header.Fields, strings.Fields(dataLine), disallowedField)
 			dataData = append(dataData, disAllowedFieldValue)
 		}
 	}
 
+	// the lines of the TC line types are grouped by their INIT too, see TCGrouping
+	dataKey, idHeaderData := p.groupByInit(header, fileLineType, dataLine, headerData, dataKey)
 	// get the tmpHeaderData without the NA values
-	tmpHeaderData := getTmpHeaderSanNA(headerData, descIndex)
-	if *docPtr == nil {
-		newDoc := make(map[string]interface{})
-		docPtr = &newDoc
-	}
+	tmpHeaderData := getTmpHeaderSanNA(idHeaderData, descIndex, p.descLength)
 	// GetId will fill in the id field of the metaData struct with the constructed id
 	// metadata doesn't change between versions, we just use the latest one. Same with DOC
-	metaData, _err := util.GetId(dataSetName, tmpHeaderData, &util.VxMetadata{Subset: "MET", Type: "DD", SubType: "MET"})
+	metaData, _err := util.GetId(dataSetName, tmpHeaderData, &util.VxMetadata{Subset: p.subset, Type: p.docType, SubType: p.subType})
 	if _err != nil {
-		return *docPtr, fmt.Errorf("error getting id from line %s: %w", dataLine, _err)
-	}
-	_, exists := (*docPtr)[metaData.ID]
-	if !exists {
-		// check to see if there is an existing external document for this id
-		externalExistingDoc, err := (getExternalDocForId)(metaData.ID)
-		if err != nil && !strings.HasPrefix(err.Error(), DOC_NOT_FOUND) {
-			return *docPtr, err
-		}
-		// if there is an external document for this id, use it, we will add the data into it
-		if externalExistingDoc != nil {
-			(*docPtr)[metaData.ID] = externalExistingDoc
-		} else {
-			// have to create a new document for this id
-			metaDataMap, _err := getMetaDataMap(metaData)
-			if _err != nil {
-				return *docPtr, _err
-			}
-			// create a new document for the new metaData.ID
-			// This function will also fill in the headerData fields
-			// indexed by dataKey value in the document.
-			// The document needs to be of the correct version.
-			switch parserVersion {
-			case "v10_0":
-				(*docPtr)[metaData.ID], _err = v10_0.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
-			case "v10_1":
-				(*docPtr)[metaData.ID], _err = v10_1.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
-			case "v11_0":
-				(*docPtr)[metaData.ID], _err = v11_0.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
-			case "v11_1":
-				(*docPtr)[metaData.ID], _err = v11_1.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
-			case "v12_0":
-				(*docPtr)[metaData.ID], _err = v12_0.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
-			default:
-				return *docPtr, fmt.Errorf("unsupported version %s", parserVersion)
-			}
-			if _err != nil || (*docPtr)[metaData.ID] == nil {
-				return *docPtr, fmt.Errorf("error creating doc for file: %s error: %w", fileName, _err)
-			}
-			// add the dataSetName to the header - dataSetName is not part of the structure
-			(*docPtr)[metaData.ID].(map[string]interface{})["dataSetName"] = dataSetName
-			// return the new doc - the doc was created and the data was added to it
-			return *docPtr, _err
-		}
-	} else {
-		// we either had the doc already, got it externally, or created it
-		// now we need to add the data to the document
-		docMap := (*docPtr)[metaData.ID].(map[string]interface{})
-		switch parserVersion {
-		case "v10_0":
-			// add the data to the document
-			(*docPtr)[metaData.ID], _err = v10_0.AddDataElement(dataKey, fileLineType, dataData, &docMap)
-		case "v10_1":
-			// add the data to the document
-			(*docPtr)[metaData.ID], _err = v10_1.AddDataElement(dataKey, fileLineType, dataData, &docMap)
-		case "v11_0":
-			// add the data to the document
-			(*docPtr)[metaData.ID], _err = v11_0.AddDataElement(dataKey, fileLineType, dataData, &docMap)
-		case "v11_1":
-			// add the data to the document
-			(*docPtr)[metaData.ID], _err = v11_1.AddDataElement(dataKey, fileLineType, dataData, &docMap)
-		case "v12_0":
-			// add the data to the document
-			(*docPtr)[metaData.ID], _err = v12_0.AddDataElement(dataKey, fileLineType, dataData, &docMap)
-		default:
-			return *docPtr, fmt.Errorf("unsupported version %s", parserVersion)
-		}
-		if _err != nil {
-			return *docPtr, fmt.Errorf("error getting doc for file: %s error: %w", fileName, _err)
-		}
+		return fileLineType, "", collisions, p.newLineError(header, fileName, parserVersion, fileLineType, dataLine, fmt.Errorf("error getting id from line %s: %w", dataLine, _err))
+	}
+	metaDataMap, _err := getMetaDataMap(metaData)
+	if _err != nil {
+		return fileLineType, metaData.ID, collisions, _err
+	}
+	// create a document for the metaData.ID with just this data line.
+	// This function will also fill in the headerData fields
+	// indexed by dataKey value in the document.
+	// The document needs to be of the correct version, or the version that it falls back to.
+	lineTypeSet, _err := p.lineTypeSetFor(parserVersion, fileHeader, fileLineType)
+	if _err != nil {
+		return fileLineType, metaData.ID, collisions, p.newLineError(header, fileName, parserVersion, fileLineType, dataLine, _err)
+	}
+	doc, _err := lineTypeSet.GetDocForId(fileLineType, metaDataMap, headerData, dataData, dataKey)
+	if _err != nil || doc == nil || doc["data"] == nil {
+		// GetDocForId only fails for line types that it does not know about
+		return fileLineType, metaData.ID, collisions, p.newLineError(header, fileName, parserVersion, fileLineType, dataLine, fmt.Errorf("%w: error creating doc for file: %s error: %v", ErrUnknownLineType, fileName, _err))
 	}
-	return *docPtr, _err
+	// add the dataSetName to the header - dataSetName is not part of the structure
+	doc["dataSetName"] = dataSetName
+	// the missing values are nil, write them the way the policy says and record them in the data entry
+	p.applyMissingValues(doc)
+	// the store adds the data to an existing document for this id (i.e. one that it looked up externally)
+	// or keeps the new document, data that is already in the document is merged by the CollisionPolicy
+	collisions, _err = p.mergeDocument(store, metaData.ID, fileLineType, doc)
+	if _err != nil {
+		return fileLineType, metaData.ID, collisions, p.newLineError(header, fileName, parserVersion, fileLineType, dataLine, _err)
+	}
+	return fileLineType, metaData.ID, collisions, nil
 }
 
 /*
@@ -232,15 +268,15 @@ func getMetaDataMap(metaData util.VxMetadata) (map[string]interface{}, error) {
 /*
 create a tmpHeaderData and remove the "" and the NA values fromm the headerData.
 This also has to be done in the GetDocForId i.e. (fill_XXXX_Header) functions,
-and trim the desc field data to 10 chars, if it isn't empty ("")
+and trim the desc field data to descLength chars, if it isn't empty ("") and descLength is not 0
 */
-func getTmpHeaderSanNA(headerData []string, descIndex int) []string {
+func getTmpHeaderSanNA(headerData []string, descIndex int, descLength int) []string {
 	tmpHeaderData := []string{}
 	for i, h := range headerData {
 		if h != "NA" && h != "" {
 			if i == descIndex {
-				if len(h) > 10 {
-					h = h[:10]
+				if descLength > 0 && len(h) > descLength {
+					h = h[:descLength]
 				}
 			}
 			tmpHeaderData = append(tmpHeaderData, h)
@@ -263,6 +299,24 @@ func WriteJsonToCompressedFile(doc map[string]interface{}, filename string) erro
 	for _, tx := range doc {
 		docList = append(docList, tx)
 	}
+	return writeDocListToCompressedFile(docList, filename)
+}
+
+// WriteStoreJsonToCompressedFile writes the documents of a DocumentStore like WriteJsonToCompressedFile.
+func WriteStoreJsonToCompressedFile(store DocumentStore, filename string) error {
+	docList := make([]interface{}, 0, store.Len())
+	err := store.Range(func(id string, doc map[string]interface{}) bool {
+		docList = append(docList, doc)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return writeDocListToCompressedFile(docList, filename)
+}
+
+// writeDocListToCompressedFile writes the documents as a gzipped JSON array.
+func writeDocListToCompressedFile(docList []interface{}, filename string) error {
 	// Marshal the document struct to JSON
 	jsonBytes, err := json.Marshal(docList)
 	if err != nil {
@@ -282,7 +336,7 @@ func WriteJsonToCompressedFile(doc map[string]interface{}, filename string) erro
 	// Write the compressed data to a file
 	err = os.WriteFile(filename, b.Bytes(), 0o644)
 	if err != nil {
-		fmt.Printf("Failed to write file: %v", err)
+		return fmt.Errorf("failed to write file: %w", err)
 	}
 	return nil
 }
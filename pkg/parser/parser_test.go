@@ -8,15 +8,19 @@ go test -cpuprofile cpu.prof -memprofile mem.prof -bench .
 
 */
 import (
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -25,10 +29,19 @@ import (
 	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_0"
 	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v11_1"
 	"github.com/NOAA-GSL/METstat2json/pkg/linetypes/v12_0"
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
 )
 
 var testdataDir = ""
 
+// the name and the header line of a grid_stat .stat file, a VAL1L2 data line of that file and the header line of a MODE CTS file that the tests share
+const (
+	statFileName      = "grid_stat_GFS_TMP_vs_ANLYS_TMP_Z2_900000L_20241104_180000V.stat"
+	statHeaderLine    = "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG  FCST_VALID_END  OBS_LEAD OBS_VALID_BEG   OBS_VALID_END   FCST_VAR  FCST_UNITS FCST_LEV OBS_VAR   OBS_UNITS OBS_LEV  OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
+	val1l2DataLine    = "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	modeCtsHeaderLine = "VERSION MODEL N_VALID GRID_RES DESC FCST_LEAD FCST_VALID      FCST_ACCUM OBS_LEAD OBS_VALID       OBS_ACCUM FCST_RAD FCST_THR OBS_RAD OBS_THR FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE FIELD  TOTAL FY_OY FY_ON FN_OY FN_ON BASER   FMEAN    ACC     FBIAS  PODY       PODN    POFD     FAR     CSI        GSS       HK        HSS       ODDS      LODDS   ORSS     EDS      SEDS     EDI      SEDI     BAGSS"
+)
+
 func getTestDataDir() (string, error) {
 	if testdataDir == "" {
 		// get the directory for testing.
@@ -80,8 +93,8 @@ func getExistingExternalDocForId(id string) (map[string]interface{}, error) {
 	dataData := []string{"4114", "0.022881", "-0.055846", "-0.23975", "0.11316", "1.40894", "2.39774", "6.07755", "1.35071", "2.1488", "4114", "12.11241", "65.18733", "6744.28012"}
 	dataKey := "120000"
 	var _err error
-	metVersion := strings.ReplaceAll(strings.ToLower(strings.Fields(id)[3]), ".", "_")
-	parserVersion := strings.Join(strings.Split(metVersion, `_`)[0:1], "_")
+	metVersion := strings.ReplaceAll(strings.ToLower(strings.Split(id, ":")[4]), ".", "_")
+	parserVersion := strings.Join(strings.Split(metVersion, `_`)[0:2], "_")
 	var doc map[string]interface{}
 	switch parserVersion {
 	case "v12_0":
@@ -129,9 +142,9 @@ func ReadJsonFromGzipFile(filename string) (map[string]interface{}, error) {
 }
 
 func TestGetMissingExternalDocForId(t *testing.T) {
-	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG  FCST_VALID_END  OBS_LEAD OBS_VALID_BEG   OBS_VALID_END   FCST_VAR  FCST_UNITS FCST_LEV OBS_VAR   OBS_UNITS OBS_LEV  OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
-	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
-	fName := "grid_stat_GFS_TMP_vs_ANLYS_TMP_Z2_900000L_20241104_180000V.stat"
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
+	fName := statFileName
 	var doc map[string]interface{}
 	doc, err := ParseLine("test", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
 	if err != nil {
@@ -154,9 +167,9 @@ func TestUnsupportedVersion(t *testing.T) {
 }
 
 func TestGetExistingExternalDocForId(t *testing.T) {
-	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG  FCST_VALID_END  OBS_LEAD OBS_VALID_BEG   OBS_VALID_END   FCST_VAR  FCST_UNITS FCST_LEV OBS_VAR   OBS_UNITS OBS_LEV  OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
-	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
-	fName := "grid_stat_GFS_TMP_vs_ANLYS_TMP_Z2_900000L_20241104_180000V.stat"
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
+	fName := statFileName
 	var doc map[string]interface{}
 	doc, err := ParseLine("test", headerLine, dataLine, &doc, fName, getExistingExternalDocForId)
 	if err != nil {
@@ -166,8 +179,8 @@ func TestGetExistingExternalDocForId(t *testing.T) {
 
 func TestParseVAL1L2(t *testing.T) {
 	/* two of these data lines (dataLine and dataLine2) are the same. Only one of them should show up in the doc. The dataLine3 and dataLine4 only differ by their desc.*/
-	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG  FCST_VALID_END  OBS_LEAD OBS_VALID_BEG   OBS_VALID_END   FCST_VAR  FCST_UNITS FCST_LEV OBS_VAR   OBS_UNITS OBS_LEV  OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
-	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
 	dataLine2 := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LMV     NEAREST     1           NA          NA         NA         NA    VAL1L2     393   -0.32297       0.32197       -0.79039       0.14006       1.34214     1.86519     3.95307      1.23297    1.78245    393           26.10387   54.98572  4500.31836"
 	dataLine3 := "V12.0.0 FCST  NA   180000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LMV     NEAREST     1           NA          NA         NA         NA    VAL1L2     393   -0.32297       0.32197       -0.79039       0.14006       1.34214     1.86519     3.95307      1.23297    1.78245    393           26.10387   54.98572  4500.31836"
 	dataLine4 := "V12.0.0 FCST  this_is_a_long_description_field   180000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LMV     NEAREST     1           NA          NA         NA         NA    VAL1L2     393   -0.32297       0.32197       -0.79039       0.14006       1.34214     1.86519     3.95307      1.23297    1.78245    393           26.10387   54.98572  4500.31836"
@@ -214,8 +227,8 @@ func TestParseVAL1L2(t *testing.T) {
 	doc0Data120000 := doc0Data["120000"]
 	doc2Data180000 := doc2Data["180000"]
 	doc0DataMap := doc0Data120000
-	doc0DataTotal := doc0DataMap.TOTAL
-	doc2DataTotal := doc2Data180000.TOTAL
+	doc0DataTotal := *doc0DataMap.TOTAL
+	doc2DataTotal := *doc2Data180000.TOTAL
 
 	parsedDoc0 := parsedDoc["MET:DD:MET:test:V12.0.0:FCST:1333972800:1333972800:000000:1333971000:1333974600:UGRD_VGRD:m/s:Z10:UGRD_VGRD:Z10:ADPSFC:LAND_L0:NEAREST:1:VAL1L2"].(map[string]interface{})
 	parsedDoc2 := parsedDoc["MET:DD:MET:test:V12.0.0:FCST:this_is_a_:1333972800:1333972800:000000:1333971000:1333974600:UGRD_VGRD:m/s:Z10:UGRD_VGRD:Z10:ADPSFC:LMV:NEAREST:1:VAL1L2"].(map[string]interface{})
@@ -231,13 +244,1133 @@ func TestParseVAL1L2(t *testing.T) {
 	// Add more assertions based on the expected structure of parsedDoc
 }
 
+func TestParseReader(t *testing.T) {
+	/* the header is repeated in the middle of the file and there is an empty line and a line with a bad version */
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
+	dataLine2 := "V12.0.0 FCST  NA   180000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2     393   -0.32297       0.32197       -0.79039       0.14006       1.34214     1.86519     3.95307      1.23297    1.78245    393           26.10387   54.98572  4500.31836"
+	dataLine3 := "V12.0.0 FCST  NA   180000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LMV     NEAREST     1           NA          NA         NA         NA    VAL1L2     393   -0.32297       0.32197       -0.79039       0.14006       1.34214     1.86519     3.95307      1.23297    1.78245    393           26.10387   54.98572  4500.31836"
+	badVersionLine := "V12 FCST  NA   180000    20120409_120000"
+	fileContent := strings.Join([]string{headerLine, dataLine, "", dataLine2, headerLine, dataLine3, badVersionLine}, "\n") + "\n"
+	fName := statFileName
+	var doc map[string]interface{}
+	doc, summary, err := ParseReader(strings.NewReader(fileContent), fName, "test", &doc, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, 7, summary.Lines)
+	assert.Equal(t, 2, summary.HeaderLines)
+	assert.Equal(t, 3, summary.DataLines)
+	assert.Equal(t, 1, summary.SkippedLines)
+	assert.Equal(t, 1, summary.ErrorLines)
+	assert.Len(t, summary.Errors, 1)
+	assert.Equal(t, 3, summary.LineTypes["STAT_VAL1L2"])
+	assert.Equal(t, 2, summary.Documents)
+	assert.Len(t, doc, 2)
+	doc0 := doc["MET:DD:MET:test:V12.0.0:FCST:1333972800:1333972800:000000:1333971000:1333974600:UGRD_VGRD:m/s:Z10:UGRD_VGRD:Z10:ADPSFC:LAND_L0:NEAREST:1:VAL1L2"].(map[string]interface{})
+	assert.Len(t, doc0["data"].(map[string]v12_0.STAT_VAL1L2), 2)
+
+	// a file that does not begin with a header line is an error
+	_, _, err = ParseReader(strings.NewReader(dataLine+"\n"), fName, "test", &doc, getMissingExternalDocForId)
+	assert.Error(t, err)
+}
+
+func TestLineErrors(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
+	fName := statFileName
+	tests := []struct {
+		name        string
+		dataSetName string
+		dataLine    string
+		wantErr     error
+		wantType    string
+		wantVersion string
+		wantColumn  string
+	}{
+		{name: "truncated", dataSetName: "test", dataLine: "V12.0.0 FCST  NA   180000    20120409_120000", wantErr: ErrTruncatedLine, wantVersion: "v12_0", wantColumn: "FCST_VALID_END"},
+		{name: "unknown line type", dataSetName: "test", dataLine: strings.Replace(dataLine, "VAL1L2", "NOTALINE", 1), wantErr: ErrUnknownLineType, wantType: "STAT_NOTALINE", wantVersion: "v12_0", wantColumn: "LINE_TYPE"},
+		{name: "unsupported version", dataSetName: "test", dataLine: strings.Replace(dataLine, "V12.0.0", "V9.1.0", 1), wantErr: ErrUnsupportedVersion, wantType: "STAT_VAL1L2", wantVersion: "v9_1", wantColumn: "VERSION"},
+		{name: "invalid version", dataSetName: "test", dataLine: strings.Replace(dataLine, "V12.0.0", "V9", 1), wantErr: ErrInvalidVersion, wantColumn: "VERSION"},
+		{name: "dataSetName too long", dataSetName: "test_dataset", dataLine: dataLine, wantErr: ErrIdTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc map[string]interface{}
+			_, err := ParseLine(tt.dataSetName, headerLine, tt.dataLine, &doc, fName, getMissingExternalDocForId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+			}
+			var lineErr *LineError
+			if errors.As(err, &lineErr) {
+				assert.Equal(t, fName, lineErr.FileName)
+				assert.Equal(t, tt.wantType, lineErr.LineType)
+				assert.Equal(t, tt.wantVersion, lineErr.Version)
+				assert.Equal(t, tt.wantColumn, lineErr.Column)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidDataSetName)
+			}
+		})
+	}
+
+	// ParseReader adds the line numbers
+	fileContent := strings.Join([]string{headerLine, dataLine, "V12.0.0 FCST  NA   180000    20120409_120000"}, "\n")
+	var doc map[string]interface{}
+	_, summary, err := ParseReader(strings.NewReader(fileContent), fName, "test", &doc, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, summary.Errors, 1)
+	assert.Equal(t, 3, summary.Errors[0].LineNumber)
+	assert.ErrorIs(t, summary.Errors[0], ErrTruncatedLine)
+}
+
+func TestRecoveredPanics(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := val1l2DataLine
+	truncatedLine := "V12.0.0 FCST  NA   180000    20120409_120000"
+	fName := statFileName
+	getPanickingExternalDocForId := func(id string) (map[string]interface{}, error) {
+		panic("external document lookup failed")
+	}
+
+	var doc map[string]interface{}
+	_, err := ParseLine("test", headerLine, dataLine, &doc, fName, getPanickingExternalDocForId)
+	if !errors.Is(err, ErrPanic) {
+		t.Fatalf("Expected %v, got %v", ErrPanic, err)
+	}
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Expected a PanicError, got %v", err)
+	}
+	assert.Equal(t, "external document lookup failed", panicErr.Value)
+	assert.Equal(t, dataLine, panicErr.Line)
+	assert.NotEmpty(t, panicErr.Stack)
+
+	// every rejected line goes to the dead letter writer
+	var deadLetters bytes.Buffer
+	var rejected []*LineError
+	fileContent := strings.Join([]string{headerLine, dataLine, truncatedLine}, "\n")
+	doc = nil
+	_, summary, err := ParseReader(strings.NewReader(fileContent), fName, "test", &doc, getPanickingExternalDocForId,
+		WithDeadLetterWriter(&deadLetters))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, 0, summary.DataLines)
+	assert.Equal(t, 2, summary.ErrorLines)
+	records := strings.Split(strings.TrimSpace(deadLetters.String()), "\n")
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 dead letter records, got %d: %s", len(records), deadLetters.String())
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(records[0]), &record); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, fName, record["fileName"])
+	assert.Equal(t, float64(2), record["lineNumber"])
+	assert.Equal(t, dataLine, record["line"])
+	assert.Contains(t, record["error"], ErrPanic.Error())
+
+	doc = nil
+	_, _, err = ParseReader(strings.NewReader(fileContent), fName, "test", &doc, getMissingExternalDocForId,
+		WithDeadLetter(func(lineErr *LineError) { rejected = append(rejected, lineErr) }))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(rejected) != 1 {
+		t.Fatalf("Expected 1 rejected line, got %d", len(rejected))
+	}
+	assert.Equal(t, 3, rejected[0].LineNumber)
+	assert.Equal(t, truncatedLine, rejected[0].Line)
+	assert.ErrorIs(t, rejected[0], ErrTruncatedLine)
+}
+
+func TestParseDirectory(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   %s    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC %s NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    %s     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	root := t.TempDir()
+	files := map[string][]string{
+		// the same document ids with different leads, the 120000 lead of LAND_L0 is in two files, the later file wins
+		"a/grid_stat_GFS_120000L_20120409_120000V.stat":     {fmt.Sprintf(dataLine, "120000", "LAND_L0", "0.1"), fmt.Sprintf(dataLine, "120000", "LMV", "0.1")},
+		"a/grid_stat_GFS_180000L_20120409_120000V.stat":     {fmt.Sprintf(dataLine, "180000", "LAND_L0", "0.2"), fmt.Sprintf(dataLine, "180000", "LMV", "0.2"), "V12.0.0 FCST"},
+		"b/grid_stat_GFS_120000L_20120409_120000V.stat":     {fmt.Sprintf(dataLine, "120000", "LAND_L0", "0.3"), "V12.0.0 FCST  NA   180000    20120409_120000"},
+		"b/grid_stat_GFS_240000L_20120409_120000V.stat":     {fmt.Sprintf(dataLine, "240000", "WATER", "0.4")},
+		"b/grid_stat_GFS_240000L_20120409_120000V.stat.swp": {fmt.Sprintf(dataLine, "240000", "SWP", "0.5")},
+		"c/grid_stat_GFS_240000L_20120409_120000V.stat":     {fmt.Sprintf(dataLine, "240000", "EXCLUDED", "0.6")},
+		"a/grid_stat_GFS_240000L_20120409_120000V.txt":      {fmt.Sprintf(dataLine, "240000", "NOT_STAT", "0.7")},
+	}
+	for name, lines := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := os.WriteFile(path, []byte(headerLine+"\n"+strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	// the same files parsed one after another
+	var expected map[string]interface{}
+	for _, name := range []string{"a/grid_stat_GFS_120000L_20120409_120000V.stat", "a/grid_stat_GFS_180000L_20120409_120000V.stat", "b/grid_stat_GFS_120000L_20120409_120000V.stat", "b/grid_stat_GFS_240000L_20120409_120000V.stat"} {
+		if _, _, err := ParseFile(filepath.Join(root, name), "test", &expected, getMissingExternalDocForId); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	expectedJson, _ := json.Marshal(expected)
+
+	for _, workers := range []int{1, 2, 8} {
+		t.Run(fmt.Sprintf("workers %d", workers), func(t *testing.T) {
+			var doc map[string]interface{}
+			// the dead letter function is not safe for concurrent use
+			var rejected []*LineError
+			doc, summary, err := ParseDirectory(root, "test", &doc, getMissingExternalDocForId,
+				WithWorkers(workers), WithQueueSize(1), WithInclude("*.stat", "*.swp"), WithExclude("c"),
+				WithDeadLetter(func(lineErr *LineError) { rejected = append(rejected, lineErr) }))
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			assert.Len(t, summary.Files, 4)
+			assert.Equal(t, 2, summary.SkippedFiles)
+			assert.Empty(t, summary.FileErrors)
+			assert.Equal(t, 6, summary.DataLines)
+			assert.Equal(t, 2, summary.ErrorLines)
+			assert.Len(t, rejected, 2)
+			assert.Equal(t, 3, summary.Documents)
+			assert.Equal(t, "grid_stat_GFS_120000L_20120409_120000V.stat", summary.Files[2].FileName)
+			// the 120000 lead of LAND_L0 collides when the later file is merged
+			assert.Equal(t, map[string]int{"STAT_VAL1L2": 1}, summary.Collisions)
+			assert.Empty(t, summary.Duplicates)
+			docJson, _ := json.Marshal(doc)
+			assert.JSONEq(t, string(expectedJson), string(docJson))
+		})
+	}
+
+	_, _, err := ParseDirectory(root, "test", nil, getMissingExternalDocForId, WithInclude("["))
+	assert.ErrorIs(t, err, filepath.ErrBadPattern)
+}
+
+func TestDocumentStore(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   %s    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC %s NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	fName := statFileName
+
+	store := NewMemoryStore(nil)
+	_, err := store.Get("missing")
+	assert.ErrorIs(t, err, ErrDocNotFound)
+
+	// the store is shared by concurrent parsers
+	masks := []string{"LAND_L0", "LMV", "WATER"}
+	leads := []string{"000000", "060000", "090000", "180000", "240000"}
+	errs := make(chan error, len(masks)*len(leads))
+	for _, mask := range masks {
+		for _, lead := range leads {
+			go func() {
+				errs <- ParseLineToStore("test", headerLine, fmt.Sprintf(dataLine, lead, mask), fName, store)
+			}()
+		}
+	}
+	for range len(masks) * len(leads) {
+		if err := <-errs; err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	assert.Equal(t, len(masks), store.Len())
+	err = store.Range(func(id string, doc map[string]interface{}) bool {
+		assert.Len(t, doc["data"], len(leads))
+		assert.Equal(t, "test", doc["dataSetName"])
+		return true
+	})
+	assert.NoError(t, err)
+
+	// the callback store looks up a document once and adds the data to it
+	externalDoc, err := getExistingExternalDocForId("MET:DD:MET:test:V12.0.0:FCST:1333972800:1333972800:000000:1333971000:1333974600:UGRD_VGRD:m/s:Z10:UGRD_VGRD:Z10:ADPSFC:LAND_L0:NEAREST:1:VAL1L2")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	lookups := 0
+	callbackStore := NewCallbackStore(NewMemoryStore(nil), func(id string) (map[string]interface{}, error) {
+		lookups++
+		if id == externalDoc["id"] {
+			return externalDoc, nil
+		}
+		return nil, fmt.Errorf("%w: %s", ErrDocNotFound, id)
+	})
+	for _, lead := range leads {
+		for _, mask := range masks {
+			if err := ParseLineToStore("test", headerLine, fmt.Sprintf(dataLine, lead, mask), fName, callbackStore); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+	}
+	assert.Equal(t, len(masks), lookups)
+	doc, err := callbackStore.Get(externalDoc["id"].(string))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// the external document has a dataKey (120000) that is not one of the leads
+	assert.Len(t, doc["data"], len(leads)+1)
+
+	tmpDir := t.TempDir()
+	if err := WriteStoreJsonToCompressedFile(callbackStore, tmpDir+"/test_output.json.gz"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	parsedDoc, err := ReadJsonFromGzipFile(tmpDir + "/test_output.json.gz")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, parsedDoc, len(masks))
+}
+
+func TestParserOptions(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  this_is_a_long_description_field   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	fName := statFileName
+
+	// the default parser
+	var doc map[string]interface{}
+	doc, err := ParseLine("test", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for id := range doc {
+		assert.True(t, strings.HasPrefix(id, "MET:DD:MET:test:V12.0.0:FCST:this_is_a_:"), id)
+	}
+
+	p := New(WithSubset("METdev"), WithType("DDX"), WithSubType("METX"), WithMaxDataSetNameLength(0), WithDescLength(0))
+	doc = nil
+	doc, err = p.ParseLine("a_long_dataset_name", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, doc, 1)
+	for id, d := range doc {
+		assert.True(t, strings.HasPrefix(id, "METdev:DDX:METX:a_long_dataset_name:V12.0.0:FCST:this_is_a_long_description_field:"), id)
+		assert.Equal(t, "METdev", d.(map[string]interface{})["subset"])
+		assert.Equal(t, "DDX", d.(map[string]interface{})["type"])
+		assert.Equal(t, "METX", d.(map[string]interface{})["subtype"])
+	}
+
+	p = New(WithMaxDataSetNameLength(4), WithDescLength(4), WithDataSetNameRule(func(dataSetName string) error {
+		if strings.ToLower(dataSetName) != dataSetName {
+			return fmt.Errorf("dataSetName %s must be lower case", dataSetName)
+		}
+		return nil
+	}))
+	doc = nil
+	_, err = p.ParseLine("tests", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+	assert.ErrorIs(t, err, ErrIdTooLong)
+	_, err = p.ParseLine("Test", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+	assert.ErrorIs(t, err, ErrInvalidDataSetName)
+	doc, err = p.ParseLine("test", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for id := range doc {
+		assert.True(t, strings.HasPrefix(id, "MET:DD:MET:test:V12.0.0:FCST:this:"), id)
+	}
+}
+
+func TestZeroAndMissingValues(t *testing.T) {
+	headerLine := statHeaderLine
+	// UFABAR is 0 and VFABAR is NA
+	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0     NA      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	fName := statFileName
+	getData := func(p *Parser) map[string]interface{} {
+		var doc map[string]interface{}
+		doc, err := p.ParseLine("test", headerLine, dataLine, &doc, fName, getMissingExternalDocForId)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		// marshal the doc the same way WriteJsonToCompressedFile does
+		for _, d := range doc {
+			jsonBytes, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			var parsed map[string]interface{}
+			if err := json.Unmarshal(jsonBytes, &parsed); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			return parsed["data"].(map[string]interface{})["120000"].(map[string]interface{})
+		}
+		t.Fatalf("Expected a document")
+		return nil
+	}
+
+	data := getData(New())
+	assert.Equal(t, float64(0), data["ufabar"])
+	assert.NotContains(t, data, "vfabar")
+	assert.Equal(t, float64(4114), data["total"])
+
+	data = getData(New(WithMissingValues(MissingNull)))
+	assert.Equal(t, float64(0), data["ufabar"])
+	assert.Contains(t, data, "vfabar")
+	assert.Nil(t, data["vfabar"])
+	assert.Equal(t, float64(4114), data["total"])
+}
+
+/*
+This test tests that missing header, scalar and sequence values are all handled by the same policy,
+and that the missing data fields are listed in the data entry.
+*/
+func TestMissingValuePolicies(t *testing.T) {
+	val1l2Header := statHeaderLine
+	// OBS_UNITS is NA and VFABAR is NA
+	val1l2Line := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0     NA      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	// the PCT line has an NA in its repeated thresholds
+	pctLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 APCP_06 kg/m^2        A6      APCP_06 NA        A6      ADPSFC LAND_L0 NEAREST     1           >=0.1          >=0.1         NA         NA    PCT    100    2     0.1      NA     5     0.5    3     4     1.0"
+	fName := statFileName
+	getDoc := func(p *Parser, dataLine string) map[string]interface{} {
+		var doc map[string]interface{}
+		doc, err := p.ParseLine("test", val1l2Header, dataLine, &doc, fName, getMissingExternalDocForId)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, d := range doc {
+			jsonBytes, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			var parsed map[string]interface{}
+			if err := json.Unmarshal(jsonBytes, &parsed); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			return parsed
+		}
+		t.Fatalf("Expected a document")
+		return nil
+	}
+	getEntry := func(doc map[string]interface{}) map[string]interface{} {
+		return doc["data"].(map[string]interface{})["120000"].(map[string]interface{})
+	}
+	// returns the missing sequence names that begin with prefix
+	missingWithPrefix := func(entry map[string]interface{}, prefix string) []string {
+		var names []string
+		missing, _ := entry["missing"].([]interface{})
+		for _, name := range missing {
+			if strings.HasPrefix(name.(string), prefix) {
+				names = append(names, name.(string))
+			}
+		}
+		return names
+	}
+
+	// omit
+	doc := getDoc(New(), val1l2Line)
+	assert.NotContains(t, doc, "OBS_UNITS")
+	assert.Equal(t, "m/s", doc["FCST_UNITS"])
+	entry := getEntry(doc)
+	assert.NotContains(t, entry, "vfabar")
+	assert.Equal(t, []interface{}{"vfabar"}, entry["missing"])
+	doc = getDoc(New(), pctLine)
+	entry = getEntry(doc)
+	missingThresh := missingWithPrefix(entry, "thresh.")
+	assert.Len(t, missingThresh, 1)
+	assert.NotContains(t, entry["thresh"], strings.TrimPrefix(missingThresh[0], "thresh."))
+
+	// null
+	p := New(WithMissingValues(MissingNull))
+	doc = getDoc(p, val1l2Line)
+	assert.Contains(t, doc, "OBS_UNITS")
+	assert.Nil(t, doc["OBS_UNITS"])
+	entry = getEntry(doc)
+	assert.Contains(t, entry, "vfabar")
+	assert.Nil(t, entry["vfabar"])
+	assert.Equal(t, float64(0), entry["ufabar"])
+	assert.Equal(t, []interface{}{"vfabar"}, entry["missing"])
+	entry = getEntry(getDoc(p, pctLine))
+	missingThresh = missingWithPrefix(entry, "thresh.")
+	assert.Len(t, missingThresh, 1)
+	thresh := entry["thresh"].(map[string]interface{})
+	assert.Contains(t, thresh, strings.TrimPrefix(missingThresh[0], "thresh."))
+	assert.Nil(t, thresh[strings.TrimPrefix(missingThresh[0], "thresh.")])
+
+	// sentinel
+	p = New(WithMissingSentinel(DefaultMissingSentinel))
+	doc = getDoc(p, val1l2Line)
+	assert.Equal(t, float64(-9999), doc["OBS_UNITS"])
+	entry = getEntry(doc)
+	assert.Equal(t, float64(-9999), entry["vfabar"])
+	assert.Equal(t, float64(0), entry["ufabar"])
+	assert.Equal(t, []interface{}{"vfabar"}, entry["missing"])
+	entry = getEntry(getDoc(p, pctLine))
+	missingThresh = missingWithPrefix(entry, "thresh.")
+	assert.Len(t, missingThresh, 1)
+	thresh = entry["thresh"].(map[string]interface{})
+	assert.Equal(t, float64(-9999), thresh[strings.TrimPrefix(missingThresh[0], "thresh.")])
+
+	// the data structs keep their type with the default policy and have the missing list
+	var rawDoc map[string]interface{}
+	rawDoc, err := ParseLine("test", val1l2Header, val1l2Line, &rawDoc, fName, getMissingExternalDocForId)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, d := range rawDoc {
+		data := d.(map[string]interface{})["data"].(map[string]v12_0.STAT_VAL1L2)
+		assert.Equal(t, []string{"vfabar"}, data["120000"].MISSING)
+		assert.Nil(t, data["120000"].VFABAR)
+	}
+}
+
+/*
+This test tests the typed Document view of a parsed document.
+*/
+func TestDocument(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0     NA      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	fName := statFileName
+	store := NewMemoryStore(nil)
+	if err := ParseLineToStore("test", headerLine, dataLine, fName, store); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var id string
+	_ = store.Range(func(docId string, _ map[string]interface{}) bool {
+		id = docId
+		return false
+	})
+
+	doc, err := GetDocument[v12_0.STAT_VAL1L2_header, v12_0.STAT_VAL1L2](store, id)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, id, doc.Metadata.ID)
+	assert.Equal(t, "MET", doc.Metadata.Subset)
+	assert.Equal(t, "test", doc.DataSetName)
+	assert.Equal(t, "FCST", *doc.Header.MODEL)
+	assert.Equal(t, "VAL1L2", *doc.Header.LINE_TYPE)
+	assert.Equal(t, 1, *doc.Header.INTERP_PNTS)
+	assert.Nil(t, doc.Header.OBS_UNITS)
+	assert.Equal(t, 4114, *doc.Data["120000"].TOTAL)
+	assert.Equal(t, float64(0), *doc.Data["120000"].UFABAR)
+	assert.Nil(t, doc.Data["120000"].VFABAR)
+
+	// the typed document marshals to the same JSON as the map document
+	mapDoc, err := store.Get(id)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected, err := json.Marshal(mapDoc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	actual, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.JSONEq(t, string(expected), string(actual))
+
+	// and it can be read back from the JSON
+	var readDoc Document[v12_0.STAT_VAL1L2_header, v12_0.STAT_VAL1L2]
+	if err := json.Unmarshal(actual, &readDoc); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, *doc, readDoc)
+
+	// the documents of the MissingNull and MissingSentinel policies are not converted, in memory or read back from the JSON
+	for _, p := range []*Parser{New(WithMissingValues(MissingNull)), New(WithMissingSentinel(-9999)), New(WithMissingSentinel("NA"))} {
+		policyStore := NewMemoryStore(nil)
+		if err := p.ParseLineToStore("test", headerLine, dataLine, fName, policyStore); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		_, err := GetDocument[v12_0.STAT_VAL1L2_header, v12_0.STAT_VAL1L2](policyStore, id)
+		assert.ErrorIs(t, err, ErrDocumentType)
+		assert.ErrorContains(t, err, "the missing field vfabar")
+		policyDoc, _ := policyStore.Get(id)
+		content, err := json.Marshal(policyDoc)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		err = json.Unmarshal(content, &readDoc)
+		assert.ErrorIs(t, err, ErrDocumentType)
+	}
+
+	// the wrong line type is an error
+	_, err = GetDocument[v12_0.STAT_CNT_header, v12_0.STAT_CNT](store, id)
+	assert.ErrorIs(t, err, ErrDocumentType)
+	_, err = GetDocument[v12_0.STAT_CNT_header, v12_0.STAT_CNT](store, "not_an_id")
+	assert.ErrorIs(t, err, ErrDocNotFound)
+}
+
+/*
+This test tests that every generated linetypes package is registered with the parser.
+*/
+func TestSupportedVersions(t *testing.T) {
+	assert.Equal(t, []string{"v10_0", "v10_1", "v11_0", "v11_1", "v12_0"}, SupportedVersions())
+	set, err := util.GetLineTypeSet("v12_0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Equal(t, v12_0.MetHeaderColumnsFileUrl, set.ColumnDefsUrl())
+	assert.Contains(t, set.LineTypes(), "STAT_CNT")
+	assert.Contains(t, set.LineTypes(), "MODE_OBJ")
+}
+
+/*
+This test tests that the embedded column definitions of every supported version describe the same header
+columns and line types as its linetypes package.
+*/
+func TestColumnDefsMatchLineTypes(t *testing.T) {
+	assert.Equal(t, SupportedVersions(), util.ColumnDefsVersions())
+	for _, version := range SupportedVersions() {
+		set, err := util.GetLineTypeSet(version)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		lines, err := util.ColumnDefs(version)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		var lineTypes []string
+		for _, line := range lines {
+			parts := strings.SplitN(line, " : ", 4)
+			if len(parts) < 4 {
+				t.Fatalf("Expected a column definitions line, got %q", line)
+			}
+			fileLineType := parts[1] + "_" + parts[2]
+			lineTypes = append(lineTypes, fileLineType)
+			headerColumns, _ := util.SplitColumnDefLine(fileLineType, parts[3])
+			assert.Equal(t, set.HeaderColumns(fileLineType), headerColumns, "%s %s", version, fileLineType)
+		}
+		assert.ElementsMatch(t, set.LineTypes(), lineTypes, version)
+	}
+}
+
+/*
+This test tests that a MET version without a linetypes package falls back to the nearest older version.
+*/
+func TestVersionFallback(t *testing.T) {
+	headerLine := statHeaderLine
+	// a new header column that v12_0 does not have
+	changedHeaderLine := strings.Replace(headerLine, "ALPHA", "ALPHA BETA", 1)
+	dataLine := "V12.1.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0     NA      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	changedDataLine := strings.Replace(dataLine, "NA    VAL1L2", "NA NA    VAL1L2", 1)
+	fName := statFileName
+	var warnings []error
+	warn := func(warning error) {
+		warnings = append(warnings, warning)
+	}
+	parse := func(p *Parser, headerLine string, dataLine string) (map[string]interface{}, error) {
+		store := NewMemoryStore(nil)
+		err := p.ParseLineToStore("test", headerLine, dataLine, fName, store)
+		var doc map[string]interface{}
+		_ = store.Range(func(_ string, d map[string]interface{}) bool {
+			doc = d
+			return false
+		})
+		return doc, err
+	}
+
+	// the header columns match v12_0, the fallback is reported once
+	p := New(WithWarningHandler(warn))
+	for range 2 {
+		doc, err := parse(p, headerLine, dataLine)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assert.Equal(t, "V12.1.0", doc["VERSION"])
+		assert.Equal(t, 4114, *doc["data"].(map[string]v12_0.STAT_VAL1L2)["120000"].TOTAL)
+	}
+	if assert.Len(t, warnings, 1) {
+		assert.ErrorIs(t, warnings[0], ErrUnsupportedVersion)
+		assert.NotErrorIs(t, warnings[0], ErrIncompatibleVersion)
+		assert.ErrorContains(t, warnings[0], "parsed with version v12_0")
+	}
+
+	// the header columns do not match v12_0, the warning is only issued once
+	warnings = nil
+	p = New(WithWarningHandler(warn))
+	var err error
+	for range 2 {
+		_, err = parse(p, changedHeaderLine, changedDataLine)
+		assert.ErrorIs(t, err, ErrIncompatibleVersion)
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+		assert.ErrorContains(t, err, "BETA")
+	}
+	assert.Len(t, warnings, 1)
+
+	// FallbackNearest parses the line anyway and warns, the extra column is also a column mismatch
+	warnings = nil
+	doc, err := parse(New(WithVersionFallback(FallbackNearest), WithWarningHandler(warn)), changedHeaderLine, changedDataLine)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 2)
+	assert.ErrorIs(t, warnings[0], ErrIncompatibleVersion)
+	assert.ErrorIs(t, warnings[1], ErrColumnMismatch)
+	assert.Equal(t, 4114, *doc["data"].(map[string]v12_0.STAT_VAL1L2)["120000"].TOTAL)
+
+	// FallbackNever rejects the version
+	_, err = parse(New(WithVersionFallback(FallbackNever)), headerLine, dataLine)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	assert.NotErrorIs(t, err, ErrIncompatibleVersion)
+
+	// a pinned version is not checked, only the columns are mapped
+	warnings = nil
+	_, err = parse(New(WithVersionFallback(FallbackNever), WithPinnedVersion("v12_1", "v12_0"), WithWarningHandler(warn)), changedHeaderLine, changedDataLine)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+	assert.NotErrorIs(t, warnings[0], ErrIncompatibleVersion)
+	_, err = parse(New(WithPinnedVersion("v12_1", "v13_0")), headerLine, dataLine)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	// there is no older version
+	_, err = parse(New(), headerLine, strings.Replace(dataLine, "V12.1.0", "V9.1.0", 1))
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	// a version of a newer major release does not fall back to v12_0, whatever the policy
+	for _, version := range []string{"V13.0.0", "V99.0.0"} {
+		for _, policy := range []VersionFallback{FallbackCompatible, FallbackNearest} {
+			warnings = nil
+			_, err = parse(New(WithVersionFallback(policy), WithWarningHandler(warn)), headerLine, strings.Replace(dataLine, "V12.1.0", version, 1))
+			assert.ErrorIs(t, err, ErrUnsupportedVersion, version)
+			assert.Empty(t, warnings)
+		}
+	}
+
+	// the data columns of a header line that names them are compared too
+	_, dataColumns, err := util.LineTypeColumns("v12_0", "STAT_VAL1L2")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	txtHeaderLine := headerLine + " " + strings.Join(dataColumns, " ")
+	txtName := "grid_stat_GFS_120000L_20120409_120000V_val1l2.txt"
+	store := NewMemoryStore(nil)
+	assert.NoError(t, New(WithWarningHandler(nil)).ParseLineToStore("test", txtHeaderLine, dataLine, txtName, store))
+	renamedHeaderLine := strings.Replace(txtHeaderLine, " UVFOABAR ", " UVFOABAR_NEW ", 1)
+	err = New(WithWarningHandler(nil)).ParseLineToStore("test", renamedHeaderLine, dataLine, txtName, store)
+	assert.ErrorIs(t, err, ErrIncompatibleVersion)
+	assert.ErrorContains(t, err, "the STAT_VAL1L2 data columns")
+	assert.ErrorContains(t, err, "UVFOABAR_NEW")
+	// the data columns are not compared for the version of a package
+	err = New(WithWarningHandler(nil)).ParseLineToStore("test", renamedHeaderLine, strings.Replace(dataLine, "V12.1.0", "V12.0.0", 1), txtName, store)
+	assert.NotErrorIs(t, err, ErrIncompatibleVersion)
+}
+
+/*
+This test tests that the engine parses a line into the same document as the generated linetypes package.
+*/
+func TestTableDrivenLineTypes(t *testing.T) {
+	headerLine := statHeaderLine
+	fName := statFileName
+	for _, version := range []string{"V12.0.0", "V12.1.0"} {
+		dataLine := version + " FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0     NA      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+		var docs []string
+		for _, p := range []*Parser{New(), New(WithTableDrivenLineTypes())} {
+			store := NewMemoryStore(nil)
+			if err := p.ParseLineToStore("test", headerLine, dataLine, fName, store); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			content, err := json.Marshal(store.Documents())
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			docs = append(docs, string(content))
+		}
+		assert.JSONEq(t, docs[0], docs[1], version)
+	}
+}
+
+func TestColumnMapping(t *testing.T) {
+	columns := strings.Fields("VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VALID_END OBS_LEAD OBS_VALID_BEG OBS_VALID_END FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE TOTAL UFABAR VFABAR UOABAR VOABAR UVFOABAR UVFFABAR UVOOABAR FA_SPEED_BAR OA_SPEED_BAR TOTAL_DIR DIRA_ME DIRA_MAE DIRA_MSE")
+	values := strings.Fields("V12.0.0 FCST NA 120000 20120409_120000 20120409_120000 000000 20120409_113000 20120409_123000 UGRD_VGRD m/s Z10 UGRD_VGRD NA Z10 ADPSFC LAND_L0 NEAREST 1 NA NA NA NA VAL1L2 4114 0 NA -0.23975 0.11316 1.40894 2.39774 6.07755 1.35071 2.1488 4114 12.11241 65.18733 6744.28012")
+	fName := "grid_stat_GFS_TMP_vs_ANLYS_TMP_Z2_900000L_20241104_180000V_val1l2.txt"
+	// the file swaps UFABAR and VFABAR, has an extra column and does not have DIRA_MSE
+	fileColumns := slices.Concat(columns[:25], []string{"VFABAR", "UFABAR"}, columns[27:len(columns)-1], []string{"EXTRA"})
+	fileValues := slices.Concat(values[:25], []string{values[26], values[25]}, values[27:len(values)-1], []string{"99"})
+	var warnings []error
+	parse := func(p *Parser, columns []string, values []string) (string, error) {
+		store := NewMemoryStore(nil)
+		err := p.ParseLineToStore("test", strings.Join(columns, " "), strings.Join(values, " "), fName, store)
+		content, _ := json.Marshal(store.Documents())
+		return string(content), err
+	}
+	values[len(values)-1] = "NA"
+	want, err := parse(New(), columns, values)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	p := New(WithWarningHandler(func(warning error) {
+		warnings = append(warnings, warning)
+	}))
+	for range 2 {
+		got, err := parse(p, fileColumns, fileValues)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assert.JSONEq(t, want, got)
+	}
+	// the mismatch is only reported once
+	assert.Len(t, warnings, 1)
+	var mismatch *ColumnMismatchError
+	if assert.ErrorAs(t, warnings[0], &mismatch) {
+		assert.Equal(t, "STAT_VAL1L2", mismatch.FileLineType)
+		assert.Equal(t, []string{"EXTRA"}, mismatch.Unknown)
+		assert.Equal(t, []string{"DIRA_MSE"}, mismatch.Missing)
+	}
+	// ColumnsStrict rejects the lines
+	_, err = parse(New(WithColumnMapping(ColumnsStrict)), fileColumns, fileValues)
+	assert.ErrorIs(t, err, ErrColumnMismatch)
+	var lineErr *LineError
+	if assert.ErrorAs(t, err, &lineErr) {
+		assert.Equal(t, "EXTRA", lineErr.Column)
+	}
+	// ColumnsByPosition reads the values where the line type has them
+	got, err := parse(New(WithColumnMapping(ColumnsByPosition)), fileColumns, fileValues)
+	assert.NoError(t, err)
+	assert.NotEqual(t, want, got)
+	// a .stat header ends at LINE_TYPE, only the header section is mapped by name
+	got, err = parse(New(WithColumnMapping(ColumnsStrict)), columns[:24], values)
+	assert.NoError(t, err)
+	assert.JSONEq(t, want, got)
+}
+
+func TestMatchedPairKeys(t *testing.T) {
+	headerLine := statHeaderLine
+	header := "V12.0.0 GFS NA 120000 20241104_180000 20241104_180000 000000 20241104_180000 20241104_180000 TMP K Z2 TMP K Z2 ADPSFC CONUS NEAREST 1 NA NA NA NA MPR"
+	pairs := []string{
+		"3 1 KDEN 39.85 -104.66 NA 1655 271.5 270.9 NA NA NA NA NA NA",
+		"3 2 KBOU 40.01 -105.25 NA 1655 270.2 271.1 NA NA NA NA NA NA",
+		"3 3 NA   40.50 -105.00 NA 1600 269.8 269.0 NA NA NA NA NA NA",
+	}
+	for _, fName := range []string{"point_stat_GFS_120000L_20241104_180000V.stat", "point_stat_GFS_120000L_20241104_180000V_mpr.txt"} {
+		fileHeaderLine := headerLine
+		if strings.HasSuffix(fName, "_mpr.txt") {
+			// the header line of an _mpr.txt file names the data columns
+			fileHeaderLine += " TOTAL INDEX OBS_SID OBS_LAT OBS_LON OBS_LVL OBS_ELV FCST OBS OBS_QC OBS_CLIMO_MEAN OBS_CLIMO_STDEV OBS_CLIMO_CDF FCST_CLIMO_MEAN FCST_CLIMO_STDEV"
+		}
+		store := NewMemoryStore(nil)
+		for _, pair := range pairs {
+			if err := ParseLineToStore("test", fileHeaderLine, header+" "+pair, fName, store); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+		docs := store.Documents()
+		assert.Len(t, docs, 1, fName)
+		for _, doc := range docs {
+			// every pair is kept, keyed by the lead, the INDEX and the OBS_SID if it has one
+			data := doc.(map[string]interface{})["data"].(map[string]v12_0.STAT_MPR)
+			assert.Len(t, data, 3, fName)
+			assert.Equal(t, "KDEN", data["120000:1:KDEN"].OBS_SID, fName)
+			assert.Equal(t, 271.1, *data["120000:2:KBOU"].OBS, fName)
+			assert.Equal(t, 269.0, *data["120000:3"].OBS, fName)
+		}
+	}
+}
+
+func TestTCGrouping(t *testing.T) {
+	headerLine := "VERSION AMODEL BMODEL DESC STORM_ID BASIN CYCLONE STORM_NAME INIT            LEAD   VALID INIT_MASK VALID_MASK LINE_TYPE"
+	dataLines := []string{
+		"V12.0.0 GFSO BEST NA AL092024 AL 09 HELENE 20240924_000000 240000 NA NA NA TCMPR 3 1 TS NA NA 25.0 -80.0 25.2 -80.1 12.5",
+		"V12.0.0 GFSO BEST NA AL092024 AL 09 HELENE 20240924_060000 240000 NA NA NA TCMPR 3 2 TS NA NA 26.0 -81.0 26.1 -81.2 20.5",
+	}
+	parse := func(p *Parser) map[string]interface{} {
+		store := NewMemoryStore(nil)
+		for _, dataLine := range dataLines {
+			if err := p.ParseLineToStore("test", headerLine, dataLine, "tc_pairs_al092024.tcst", store); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+		return store.Documents()
+	}
+	data := func(doc interface{}) map[string]v12_0.TCST_TCMPR {
+		return doc.(map[string]interface{})["data"].(map[string]v12_0.TCST_TCMPR)
+	}
+
+	// by default the cycles are in one document, keyed by INIT and LEAD
+	docs := parse(New())
+	assert.Len(t, docs, 1)
+	for _, doc := range docs {
+		assert.Len(t, data(doc), 2)
+		assert.Equal(t, 12.5, *data(doc)["1727136000_240000"].TK_ERR)
+		assert.Equal(t, 20.5, *data(doc)["1727157600_240000"].TK_ERR)
+	}
+
+	// one document per cycle, INIT is part of the id
+	docs = parse(New(WithTCGrouping(TCCyclePerDocument)))
+	assert.Len(t, docs, 2)
+	for id, doc := range docs {
+		assert.Len(t, data(doc), 1)
+		assert.Contains(t, data(doc), "240000")
+		assert.True(t, strings.Contains(id, "1727136000") || strings.Contains(id, "1727157600"), id)
+	}
+
+	// keyed by LEAD the later cycle replaces the earlier one
+	docs = parse(New(WithTCGrouping(TCTrackByLead)))
+	assert.Len(t, docs, 1)
+	for _, doc := range docs {
+		assert.Len(t, data(doc), 1)
+		assert.Equal(t, 20.5, *data(doc)["240000"].TK_ERR)
+	}
+}
+
+func TestModeCTSFields(t *testing.T) {
+	// the RAW and the OBJECT line of a _cts.txt file have the same header, both are kept
+	headerLine := modeCtsHeaderLine
+	dataLines := []string{
+		"V12.0.0 FCST  26026   9        NA   300000    20120410_180000 060000     120000   20050807_120000 120000    2        >=5.0    2       >=5.0   APCP_06  kg/m^2     A6       OBS     None      Surface STAGE4    RAW 26026    47  1356  5898 18725 0.22843 0.053908 0.72128 0.236  0.0079058  0.93247 0.067527 0.9665  0.0064375  -0.039178 -0.059621 -0.08155  0.11004   -2.2069 -0.80173 -0.53249 -0.3039  -0.28465 -0.28988 -0.11236",
+		"V12.0.0 FCST  26026   9        NA   300000    20120410_180000 060000     120000   20050807_120000 120000    2        >=5.0    2       >=5.0   APCP_06  kg/m^2     A6       OBS     None      Surface STAGE4 OBJECT 26026     4  1315  6322 18385 0.24306 0.05068  0.70656 0.2085 0.00063231 0.93325 0.066751 0.99697 0.00052349 -0.043249 -0.066119 -0.090409 0.0088459 -4.7278 -0.98246 -0.67783 -0.49927 -0.46256 -0.46613 -0.13686",
+	}
+	store := NewMemoryStore(nil)
+	for _, dataLine := range dataLines {
+		if err := ParseLineToStore("test", headerLine, dataLine, "mode_python_mixed_300000L_20120410_180000V_060000A_cts.txt", store); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	docs := store.Documents()
+	assert.Len(t, docs, 1)
+	for _, doc := range docs {
+		data := doc.(map[string]interface{})["data"].(map[string]v12_0.MODE_CTS)
+		assert.Len(t, data, 2)
+		assert.Equal(t, "RAW", data["300000_RAW"].FIELD)
+		assert.Equal(t, 47.0, *data["300000_RAW"].FY_OY)
+		assert.Equal(t, "OBJECT", data["300000_OBJECT"].FIELD)
+		assert.Equal(t, 4.0, *data["300000_OBJECT"].FY_OY)
+	}
+}
+
+func TestCollisionPolicies(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    %s     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	// a re-run job writes the same line again, and a line with the same id and data key but other values
+	lines := []string{fmt.Sprintf(dataLine, "0.1"), fmt.Sprintf(dataLine, "0.1"), fmt.Sprintf(dataLine, "0.2")}
+	parse := func(p *Parser) (map[string]v12_0.STAT_VAL1L2, FileSummary) {
+		store := NewMemoryStore(nil)
+		summary, err := p.ParseReaderToStore(strings.NewReader(headerLine+"\n"+strings.Join(lines, "\n")), "grid_stat_GFS_120000L_20120409_120000V.stat", "test", store)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assert.Equal(t, 1, store.Len())
+		var data map[string]v12_0.STAT_VAL1L2
+		_ = store.Range(func(id string, doc map[string]interface{}) bool {
+			data = doc["data"].(map[string]v12_0.STAT_VAL1L2)
+			return true
+		})
+		return data, summary
+	}
+
+	// the last line wins by default, the collisions are counted and reported once
+	var warnings []error
+	data, summary := parse(New(WithWarningHandler(func(warning error) {
+		warnings = append(warnings, warning)
+	})))
+	assert.Len(t, data, 1)
+	assert.Equal(t, 0.2, *data["120000"].UFABAR)
+	assert.Equal(t, map[string]int{"STAT_VAL1L2": 2}, summary.Collisions)
+	assert.Equal(t, map[string]int{"STAT_VAL1L2": 1}, summary.Duplicates)
+	assert.Equal(t, 3, summary.DataLines)
+	assert.Len(t, warnings, 1)
+	var collision *CollisionError
+	if assert.ErrorAs(t, warnings[0], &collision) {
+		assert.Equal(t, "STAT_VAL1L2", collision.FileLineType)
+		assert.Equal(t, "120000", collision.DataKey)
+		assert.True(t, collision.Duplicate)
+	}
+
+	// the first line wins
+	data, summary = parse(New(WithCollisionPolicy(CollisionFirstWins)))
+	assert.Len(t, data, 1)
+	assert.Equal(t, 0.1, *data["120000"].UFABAR)
+	assert.Equal(t, map[string]int{"STAT_VAL1L2": 2}, summary.Collisions)
+
+	// the colliding lines are rejected
+	data, summary = parse(New(WithCollisionPolicy(CollisionReject)))
+	assert.Len(t, data, 1)
+	assert.Equal(t, 0.1, *data["120000"].UFABAR)
+	assert.Equal(t, 1, summary.DataLines)
+	assert.Equal(t, 2, summary.ErrorLines)
+	if assert.Len(t, summary.Errors, 2) {
+		assert.ErrorIs(t, summary.Errors[0], ErrCollision)
+		assert.Equal(t, "FCST_LEAD", summary.Errors[0].Column)
+		assert.Equal(t, 4, summary.Errors[1].LineNumber)
+	}
+
+	// every line is kept, the later ones with a suffix
+	data, _ = parse(New(WithCollisionPolicy(CollisionKeepAll)))
+	assert.Len(t, data, 3)
+	assert.Equal(t, 0.1, *data["120000"].UFABAR)
+	assert.Equal(t, 0.1, *data["120000_2"].UFABAR)
+	assert.Equal(t, 0.2, *data["120000_3"].UFABAR)
+}
+
+// TestMergeLocksTheStore checks that the documents of a store of this package are merged under the lock of the store.
+func TestMergeLocksTheStore(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   120000    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC LAND_L0 NEAREST     1           NA          NA         NA         NA    FHO 4114 0.5 0.4 0.6"
+	p := New()
+	// the lock of the Parser is only taken for the stores without a lock of their own, i.e. not for the private
+	// store of a ParseDirectory worker
+	p.mergeMu.Lock()
+	defer p.mergeMu.Unlock()
+	done := make(chan error)
+	for _, store := range []DocumentStore{NewMemoryStore(nil), NewCallbackStore(NewMemoryStore(nil), nil)} {
+		go func() {
+			done <- p.ParseLineToStore("test", headerLine, dataLine, "grid_stat_GFS.stat", store)
+		}()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+			assert.Equal(t, 1, store.Len())
+		case <-time.After(10 * time.Second):
+			t.Fatalf("the merge into a %T waits for the lock of the Parser", store)
+		}
+	}
+}
+
+func TestGroupings(t *testing.T) {
+	headerLine := statHeaderLine
+	dataLine := "V12.0.0 FCST  NA   %s    20120409_120000 20120409_120000 000000   20120409_113000 20120409_123000 UGRD_VGRD m/s        Z10      UGRD_VGRD NA        Z10      ADPSFC %s NEAREST     1           NA          NA         NA         NA    VAL1L2    4114    0.022881     -0.055846      -0.23975       0.11316       1.40894     2.39774     6.07755      1.35071    2.1488    4114           12.11241   65.18733  6744.28012"
+	fName := statFileName
+	parse := func(p *Parser) (map[string]interface{}, error) {
+		store := NewMemoryStore(nil)
+		for _, lead := range []string{"120000", "240000"} {
+			for _, mask := range []string{"LAND_L0", "WATER"} {
+				if err := p.ParseLineToStore("test", headerLine, fmt.Sprintf(dataLine, lead, mask), fName, store); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return store.Documents(), nil
+	}
+
+	// one document per lead keyed by VX_MASK
+	p := New(WithGrouping("STAT_VAL1L2", util.DataKeyEntry{DataKey: []string{"VX_MASK"}}))
+	assert.NoError(t, p.ValidateGroupings())
+	docs, err := parse(p)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, docs, 2)
+	for id, doc := range docs {
+		// the header fields are those of the grouping, FCST_LEAD is a header field since it is not a data key
+		assert.True(t, strings.Contains(id, ":FCST:120000:") || strings.Contains(id, ":FCST:240000:"), id)
+		assert.Contains(t, doc, "FCST_LEAD")
+		assert.NotContains(t, doc, "VX_MASK")
+		assert.Len(t, doc.(map[string]interface{})["data"], 2)
+		assert.Contains(t, doc.(map[string]interface{})["data"], "WATER")
+	}
+
+	// the disallowed header columns of the grouping are moved from the header to the data
+	p = New(WithGrouping("STAT_VAL1L2", util.DataKeyEntry{DataKey: []string{"VX_MASK"}, HeaderDisallow: []string{"OBTYPE", "DESC"}}))
+	assert.NoError(t, p.ValidateGroupings())
+	docs, err = parse(p)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, docs, 2)
+	for id, doc := range docs {
+		assert.NotContains(t, id, "ADPSFC")
+		assert.NotContains(t, doc, "OBTYPE")
+		content, err := json.Marshal(doc.(map[string]interface{})["data"])
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		var data map[string]map[string]interface{}
+		if err := json.Unmarshal(content, &data); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assert.Equal(t, "ADPSFC", data["WATER"]["obtype"])
+		assert.Equal(t, 4114.0, data["WATER"]["total"])
+		// the missing DESC is a missing value of the data
+		assert.NotContains(t, data["WATER"], "desc")
+	}
+
+	// one document per valid time keyed by lead, the masks are in the data key
+	p = New(WithGrouping("STAT_VAL1L2", util.DataKeyEntry{IdColumns: []string{"VERSION", "MODEL", "FCST_VALID_BEG", "LINE_TYPE"}, DataKey: []string{"FCST_LEAD", "VX_MASK"}}))
+	docs, err = parse(p)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	assert.Len(t, docs, 1)
+	for id, doc := range docs {
+		assert.Equal(t, "MET:DD:MET:test:V12.0.0:FCST:1333972800:VAL1L2", id)
+		assert.NotContains(t, doc, "FCST_VAR")
+		assert.Len(t, doc.(map[string]interface{})["data"], 4)
+		assert.Contains(t, doc.(map[string]interface{})["data"], "240000_LAND_L0")
+	}
+
+	// a column that the line type does not have rejects the lines
+	p = New(WithGrouping("STAT_VAL1L2", util.DataKeyEntry{DataKey: []string{"FCST_LEAD", "STATION"}}))
+	_, err = parse(p)
+	assert.ErrorIs(t, err, ErrInvalidGrouping)
+	var lineErr *LineError
+	if assert.ErrorAs(t, err, &lineErr) {
+		assert.Equal(t, "STATION", lineErr.Column)
+		assert.Equal(t, "STAT_VAL1L2", lineErr.LineType)
+	}
+	assert.ErrorIs(t, p.ValidateGroupings(), ErrInvalidGrouping)
+
+	// the id and the disallowed header columns have to be header columns
+	p = New(WithGrouping("STAT_VAL1L2", util.DataKeyEntry{DataKey: []string{"FCST_LEAD"}, HeaderDisallow: []string{"TOTAL"}}), WithGrouping("STAT_NOT_A_LINE_TYPE", util.DataKeyEntry{DataKey: []string{"FCST_LEAD"}}))
+	err = p.ValidateGroupings()
+	var groupingErr *GroupingError
+	if assert.ErrorAs(t, err, &groupingErr) {
+		assert.Equal(t, "TOTAL", groupingErr.Column)
+		assert.True(t, groupingErr.Header)
+	}
+	assert.ErrorIs(t, err, ErrUnknownLineType)
+}
+
+func TestCustomLineTypes(t *testing.T) {
+	err := util.RegisterCustomLineType(util.CustomLineType{
+		FileType:    "STAT",
+		LineType:    "MY_SCORES",
+		Columns:     "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VAR LINE_TYPE TOTAL SCORE",
+		ColumnTypes: map[string]string{"TOTAL": "int", "SCORE": "float64"},
+		DataKey:     util.DataKeyEntry{DataKey: []string{"FCST_LEAD"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	err = util.RegisterCustomLineType(util.CustomLineType{
+		FileType:       "TOOL",
+		LineType:       "OBJECTS",
+		Columns:        "VERSION MODEL FCST_LEAD FCST_VALID_BEG OBJECT_NAME AREA",
+		SeparatorField: "OBJECT_NAME",
+		ColumnTypes:    map[string]string{"AREA": "float64"},
+		DataKey:        util.DataKeyEntry{DataKey: []string{"FCST_LEAD", "OBJECT_NAME"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// a stat file with a custom LINE_TYPE, the lines of every version are parsed
+	store := NewMemoryStore(nil)
+	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG  FCST_VAR LINE_TYPE"
+	for _, dataLine := range []string{
+		"V12.0.0 GFS NA 060000 20241104_180000 TMP MY_SCORES 10 0.5",
+		"V13.0.0 GFS NA 120000 20241104_180000 TMP MY_SCORES 12 NA",
+	} {
+		if err := ParseLineToStore("test", headerLine, dataLine, "my_tool_20241104.stat", store); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	// a file with a custom separator field is found from its header line
+	headerLine = "VERSION MODEL FCST_LEAD FCST_VALID_BEG OBJECT_NAME AREA"
+	if err := ParseLineToStore("test", headerLine, "V12.0.0 GFS 060000 20241104_180000 storm_1 12.5", "objects.txt", store); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	docs := store.Documents()
+	assert.Len(t, docs, 3)
+	for id, data := range map[string]string{
+		"MET:DD:MET:test:V12.0.0:GFS:1730743200:TMP:MY_SCORES": `{"060000": {"total": 10, "score": 0.5}}`,
+		"MET:DD:MET:test:V13.0.0:GFS:1730743200:TMP:MY_SCORES": `{"120000": {"total": 12, "missing": ["score"]}}`,
+		"MET:DD:MET:test:V12.0.0:GFS:1730743200":               `{"060000_storm_1": {"area": 12.5}}`,
+	} {
+		content, err := json.Marshal(docs[id].(map[string]interface{})["data"])
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assert.JSONEq(t, data, string(content), id)
+	}
+	assert.Equal(t, "MY_SCORES", docs["MET:DD:MET:test:V12.0.0:GFS:1730743200:TMP:MY_SCORES"].(map[string]interface{})["LINE_TYPE"])
+	assert.Equal(t, 1730743200, docs["MET:DD:MET:test:V12.0.0:GFS:1730743200"].(map[string]interface{})["FCST_VALID_BEG"])
+}
+
 // V10.1.1  ./tc_data/GFSO/2023060912/tc_pairs_al02.dat.tcst
 
 /*
 This test tests a data field dataKey.
 */
 func TestParseMODE_OBJ(t *testing.T) {
-	headerLine := "VERSION MODEL N_VALID GRID_RES DESC FCST_LEAD FCST_VALID      FCST_ACCUM OBS_LEAD OBS_VALID       OBS_ACCUM FCST_RAD FCST_THR OBS_RAD OBS_THR FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE FIELD  TOTAL FY_OY FY_ON FN_OY FN_ON BASER   FMEAN    ACC     FBIAS  PODY       PODN    POFD     FAR     CSI        GSS       HK        HSS       ODDS      LODDS   ORSS     EDS      SEDS     EDI      SEDI     BAGSS"
+	headerLine := modeCtsHeaderLine
 	dataLine := "V12.0.0 FCST  26026   9        NA   300000    20120410_180000 060000     120000   20050807_120000 120000    2        >=5.0    2       >=5.0   APCP_06  kg/m^2     A6       OBS     None      Surface STAGE4    RAW 26026    47  1356  5898 18725 0.22843 0.053908 0.72128 0.236  0.0079058  0.93247 0.067527 0.9665  0.0064375  -0.039178 -0.059621 -0.08155  0.11004   -2.2069 -0.80173 -0.53249 -0.3039  -0.28465 -0.28988 -0.11236"
 	dataLine2 := "V12.0.0 FCST  26026   9        this_is_a_long_description   300000    20120410_180000 060000     120000   20050807_120000 120000    2        >=5.0    2       >=5.0   APCP_06  kg/m^2     A6       OBS     None      Surface STAGE4 OBJECT 26026     4  1315  6322 18385 0.24306 0.05068  0.70656 0.2085 0.00063231 0.93325 0.066751 0.99697 0.00052349 -0.043249 -0.066119 -0.090409 0.0088459 -4.7278 -0.98246 -0.67783 -0.49927 -0.46256 -0.46613 -0.13686"
 	tmpDir := t.TempDir()
@@ -269,17 +1402,17 @@ func TestParseMODE_OBJ(t *testing.T) {
 	doc2 := doc["MET:DD:MET:test:V12.0.0:FCST:26026:9:this_is_a_:20120410_180000:060000:120000:20050807_120000:120000:2:>=5.0:2:>=5.0:APCP_06:kg/m^2:A6:OBS:None:Surface:STAGE4"].(map[string]interface{})
 	doc0Data := doc0["data"].(map[string]v12_0.MODE_CTS)
 	doc2Data := doc2["data"].(map[string]v12_0.MODE_CTS)
-	doc0Data120000 := doc0Data["300000"]
-	doc2Data180000 := doc2Data["300000"]
-	doc0DataTotal := doc0Data120000.TOTAL
-	doc2DataTotal := doc2Data180000.TOTAL
+	doc0Data120000 := doc0Data["300000_RAW"]
+	doc2Data180000 := doc2Data["300000_OBJECT"]
+	doc0DataTotal := *doc0Data120000.TOTAL
+	doc2DataTotal := *doc2Data180000.TOTAL
 
 	parsedDoc0 := parsedDoc["MET:DD:MET:test:V12.0.0:FCST:26026:9:20120410_180000:060000:120000:20050807_120000:120000:2:>=5.0:2:>=5.0:APCP_06:kg/m^2:A6:OBS:None:Surface:STAGE4"].(map[string]interface{})
 	parsedDoc2 := parsedDoc["MET:DD:MET:test:V12.0.0:FCST:26026:9:this_is_a_:20120410_180000:060000:120000:20050807_120000:120000:2:>=5.0:2:>=5.0:APCP_06:kg/m^2:A6:OBS:None:Surface:STAGE4"].(map[string]interface{})
 	parsedDoc0Data := parsedDoc0["data"].(map[string]interface{})
 	parsedDoc2Data := parsedDoc2["data"].(map[string]interface{})
-	parsedDoc0Data120000 := parsedDoc0Data["300000"].(map[string]interface{})
-	parsedDoc2Data180000 := parsedDoc2Data["300000"].(map[string]interface{})
+	parsedDoc0Data120000 := parsedDoc0Data["300000_RAW"].(map[string]interface{})
+	parsedDoc2Data180000 := parsedDoc2Data["300000_OBJECT"].(map[string]interface{})
 	parsedDoc0DataTotal := int(parsedDoc0Data120000["total"].(float64))
 	parsedDoc2DataTotal := int(parsedDoc2Data180000["total"].(float64))
 
@@ -323,12 +1456,22 @@ func TestParseMODE_OBJ_V11_1_0(t *testing.T) {
 	data := tmpDoc["data"].(map[string]v11_1.MODE_OBJ)
 	elem1Data := data["010000_F001"]
 	elem2Data := data["010000_F002"]
-	assert.Equal(t, elem1Data.CENTROID_X, 1191.36111, "expected data[\"010000_F001\"].CENTROID_X to be 1191.36111")
-	assert.Equal(t, elem2Data.CENTROID_X, 1195.86842, "expected data[\"010000_F002\"].CENTROID_X to be 1195.86842")
-	assert.Equal(t, elem1Data.CENTROID_Y, 848.40278, "expected data[\"010000_F001\"].CENTROID_Y to be 848.40278")
-	assert.Equal(t, elem2Data.CENTROID_Y, 834.47368, "expected data[\"010000_F002\"].CENTROID_Y to be 834.47368")
-	assert.Equal(t, elem1Data.CENTROID_LAT, 46.59842, "expected data[\"010000_F001\"].CENTROID_LAT to be 46.59842")
-	assert.Equal(t, elem2Data.CENTROID_LAT, 46.21429, "expected data[\"010000_F002\"].CENTROID_LAT to be 46.21429")
+	assert.Equal(t, *elem1Data.CENTROID_X, 1191.36111, "expected data[\"010000_F001\"].CENTROID_X to be 1191.36111")
+	assert.Equal(t, *elem2Data.CENTROID_X, 1195.86842, "expected data[\"010000_F002\"].CENTROID_X to be 1195.86842")
+	assert.Equal(t, *elem1Data.CENTROID_Y, 848.40278, "expected data[\"010000_F001\"].CENTROID_Y to be 848.40278")
+	assert.Equal(t, *elem2Data.CENTROID_Y, 834.47368, "expected data[\"010000_F002\"].CENTROID_Y to be 834.47368")
+	assert.Equal(t, *elem1Data.CENTROID_LAT, 46.59842, "expected data[\"010000_F001\"].CENTROID_LAT to be 46.59842")
+	assert.Equal(t, *elem2Data.CENTROID_LAT, 46.21429, "expected data[\"010000_F002\"].CENTROID_LAT to be 46.21429")
+	// the INTENSITY_95 column of the file is the INTENSITY_USER column of the line type
+	assert.Equal(t, 40.2, *elem1Data.INTENSITY_USER, "expected data[\"010000_F001\"].INTENSITY_USER to be 40.2")
+	assert.Equal(t, 33.85625, *elem2Data.INTENSITY_USER, "expected data[\"010000_F002\"].INTENSITY_USER to be 33.85625")
+	var warnings []error
+	p := New(WithWarningHandler(func(warning error) {
+		warnings = append(warnings, warning)
+	}))
+	err = p.ParseLineToStore("test", headerLine, dataLine, fName, NewMemoryStore(nil))
+	assert.NoError(t, err)
+	assert.Empty(t, warnings, "expected no column mismatch for the INTENSITY_95 column")
 }
 
 func TestModeFile(t *testing.T) {
@@ -0,0 +1,264 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+ParseReader and ParseFile parse an entire MET output file instead of a single line.
+The file is streamed with a buffered scanner so that very large files (i.e. multi GB MPR files)
+never have to be read into memory. A header line (a line that begins with VERSION) is compiled once
+and then used for every data line that follows it, until another header line is found. Some files
+have repeated header lines in the middle of the file, which happens when MET appends output of a
+different line type to an existing file, so every header line replaces the previous one.
+*/
+
+// maxLineLength is the largest line that the scanner will accept. Some line types (i.e. ORANK, RHIST) can be very long.
+const maxLineLength = 16 * 1024 * 1024
+
+// maxSummaryErrors limits the number of line errors that are kept in a FileSummary, the ErrorLines count is not limited.
+const maxSummaryErrors = 100
+
+// FileSummary describes what happened while parsing one file.
+type FileSummary struct {
+	FileName     string
+	Lines        int            // total number of lines read from the file
+	HeaderLines  int            // number of header lines i.e. lines beginning with VERSION
+	DataLines    int            // number of data lines that were successfully parsed
+	SkippedLines int            // number of empty lines
+	ErrorLines   int            // number of data lines that could not be parsed
+	LineTypes    map[string]int // successfully parsed data lines per fileLineType
+	Collisions   map[string]int // data lines per fileLineType whose document id and data key were already in the store, see CollisionPolicy
+	Duplicates   map[string]int // the collisions per fileLineType whose data was the same as the data in the store
+	Documents    int            // number of distinct document ids that the data lines were added to
+	Errors       []*LineError   // the first maxSummaryErrors line errors
+}
+
+// addError records the line number in the LineError (or wraps err in a LineError) and adds it to the summary.
+func (s *FileSummary) addError(lineNumber int, line string, err error) *LineError {
+	s.ErrorLines++
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) {
+		lineErr = &LineError{FileName: s.FileName, Line: line, Err: err}
+	}
+	lineErr.LineNumber = lineNumber
+	if len(s.Errors) < maxSummaryErrors {
+		s.Errors = append(s.Errors, lineErr)
+	}
+	return lineErr
+}
+
+// ReadOption configures ParseReader, ParseFile and ParseDirectory.
+type ReadOption func(*readConfig)
+
+type readConfig struct {
+	deadLetter func(*LineError)
+	// these only apply to ParseDirectory
+	workers   int
+	queueSize int
+	include   []string
+	exclude   []string
+	// the fileLineType of each document id, ParseDirectory uses it for the collisions of the merge
+	docLineTypes map[string]string
+}
+
+/*
+WithDeadLetter calls fn for every line that is rejected, i.e. every line that could not be parsed, including
+lines that caused a panic. The LineError has the file name, line number and the line itself, so the
+rejected lines can be re-examined after an ingest run. ParseDirectory calls fn for one line at a time, see ParseDirectory.
+*/
+func WithDeadLetter(fn func(*LineError)) ReadOption {
+	return func(c *readConfig) {
+		c.deadLetter = fn
+	}
+}
+
+// deadLetterRecord is the JSON representation of a rejected line that is written by WithDeadLetterWriter.
+type deadLetterRecord struct {
+	FileName   string `json:"fileName"`
+	LineNumber int    `json:"lineNumber"`
+	LineType   string `json:"lineType,omitempty"`
+	Version    string `json:"version,omitempty"`
+	Column     string `json:"column,omitempty"`
+	Error      string `json:"error"`
+	Line       string `json:"line"`
+}
+
+/*
+WithDeadLetterWriter writes every rejected line to w as a line of JSON (see WithDeadLetter).
+Writes are serialized so the same writer, i.e. an *os.File, can be shared by concurrent parsers.
+*/
+func WithDeadLetterWriter(w io.Writer) ReadOption {
+	var mu sync.Mutex
+	return WithDeadLetter(func(lineErr *LineError) {
+		record, err := json.Marshal(deadLetterRecord{
+			FileName:   lineErr.FileName,
+			LineNumber: lineErr.LineNumber,
+			LineType:   lineErr.LineType,
+			Version:    lineErr.Version,
+			Column:     lineErr.Column,
+			Error:      lineErr.Err.Error(),
+			Line:       lineErr.Line,
+		})
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		_, _ = w.Write(append(record, '\n'))
+	})
+}
+
+// ParseFile parses a file with the default Parser, see Parser.ParseFile.
+func ParseFile(filePath string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, FileSummary, error) {
+	return defaultParser.ParseFile(filePath, dataSetName, docPtr, getExternalDocForId, opts...)
+}
+
+// ParseFileToStore parses a file with the default Parser, see Parser.ParseFileToStore.
+func ParseFileToStore(filePath string, dataSetName string, store DocumentStore, opts ...ReadOption) (FileSummary, error) {
+	return defaultParser.ParseFileToStore(filePath, dataSetName, store, opts...)
+}
+
+// ParseReader parses the lines from r with the default Parser, see Parser.ParseReader.
+func ParseReader(r io.Reader, fileName string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, FileSummary, error) {
+	return defaultParser.ParseReader(r, fileName, dataSetName, docPtr, getExternalDocForId, opts...)
+}
+
+// ParseReaderToStore parses the lines from r with the default Parser, see Parser.ParseReaderToStore.
+func ParseReaderToStore(r io.Reader, fileName string, dataSetName string, store DocumentStore, opts ...ReadOption) (FileSummary, error) {
+	return defaultParser.ParseReaderToStore(r, fileName, dataSetName, store, opts...)
+}
+
+/*
+ParseFile opens the file at filePath and parses it with ParseReader.
+*/
+func (p *Parser) ParseFile(filePath string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, FileSummary, error) {
+	store := newMemoryStoreForDocPtr(docPtr)
+	summary, err := p.ParseFileToStore(filePath, dataSetName, NewCallbackStore(store, getExternalDocForId), opts...)
+	return store.Documents(), summary, err
+}
+
+/*
+ParseFileToStore opens the file at filePath and parses it with ParseReaderToStore.
+*/
+func (p *Parser) ParseFileToStore(filePath string, dataSetName string, store DocumentStore, opts ...ReadOption) (FileSummary, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return FileSummary{FileName: filepath.Base(filePath)}, err
+	}
+	defer file.Close()
+	return p.ParseReaderToStore(file, filePath, dataSetName, store, opts...)
+}
+
+/*
+ParseReader parses every line read from r into the documents in docPtr, the same way that ParseLine does.
+The fileName is used to detect the type of MET output, it does not have to exist. Errors for individual
+lines do not stop the parsing, they are counted and kept in the returned FileSummary. An error is only
+returned if the file cannot be read at all, the file should be skipped (ErrSkippedFile), or the file does not begin with
+a header line (ErrMissingHeader). Use WithDeadLetter or WithDeadLetterWriter to capture every rejected line.
+*/
+func (p *Parser) ParseReader(r io.Reader, fileName string, dataSetName string, docPtr *map[string]interface{}, getExternalDocForId func(id string) (map[string]interface{}, error), opts ...ReadOption) (map[string]interface{}, FileSummary, error) {
+	store := newMemoryStoreForDocPtr(docPtr)
+	summary, err := p.ParseReaderToStore(r, fileName, dataSetName, NewCallbackStore(store, getExternalDocForId), opts...)
+	return store.Documents(), summary, err
+}
+
+/*
+newMemoryStoreForDocPtr returns a MemoryStore that keeps its documents in the map that docPtr points to,
+creating the map if it is nil, so the parsed documents are also in *docPtr.
+*/
+func newMemoryStoreForDocPtr(docPtr *map[string]interface{}) *MemoryStore {
+	if docPtr == nil {
+		docPtr = &map[string]interface{}{}
+	}
+	if *docPtr == nil {
+		*docPtr = make(map[string]interface{})
+	}
+	return NewMemoryStore(*docPtr)
+}
+
+/*
+ParseReaderToStore parses every line read from r like ParseReader, but merges the data into the documents of a DocumentStore.
+*/
+func (p *Parser) ParseReaderToStore(r io.Reader, fileName string, dataSetName string, store DocumentStore, opts ...ReadOption) (FileSummary, error) {
+	var config readConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	fileName = filepath.Base(fileName)
+	summary := FileSummary{FileName: fileName, LineTypes: make(map[string]int), Collisions: make(map[string]int), Duplicates: make(map[string]int)}
+	rejectLine := func(lineNumber int, line string, err error) {
+		lineErr := summary.addError(lineNumber, line, err)
+		if config.deadLetter != nil {
+			config.deadLetter(lineErr)
+		}
+	}
+	if err := p.checkDataSetName(dataSetName); err != nil {
+		return summary, err
+	}
+	if err := checkFileName(fileName); err != nil {
+		return summary, err
+	}
+	var headerLine string
+	// the compiled header depends on the MET version of the data line, and there is normally only one
+	compiledHeaders := make(map[string]*util.CompiledHeader)
+	ids := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+	for scanner.Scan() {
+		summary.Lines++
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			summary.SkippedLines++
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "VERSION") {
+			// a new (or repeated) header line - the previously compiled headers no longer apply
+			headerLine = line
+			summary.HeaderLines++
+			clear(compiledHeaders)
+			continue
+		}
+		if headerLine == "" {
+			// the first non empty line of a file has to be a header line
+			return summary, fmt.Errorf("%w: missing VERSION at start of header line - bad header line? for file %s", ErrMissingHeader, fileName)
+		}
+		parserVersion, err := getParserVersion(line)
+		if err != nil {
+			rejectLine(summary.Lines, line, newLineError(nil, fileName, "", "", line, fmt.Errorf("error getting parser version from line %s: %w", line, err)))
+			continue
+		}
+		header, ok := compiledHeaders[parserVersion]
+		if !ok {
+			header = util.CompileHeader(headerLine, fileName, parserVersion)
+			compiledHeaders[parserVersion] = header
+		}
+		fileLineType, id, collisions, err := p.parseDataLine(dataSetName, header, parserVersion, line, store)
+		addCollisions(summary.Collisions, summary.Duplicates, fileLineType, collisions)
+		if err != nil {
+			rejectLine(summary.Lines, line, err)
+			continue
+		}
+		summary.DataLines++
+		summary.LineTypes[fileLineType]++
+		ids[id] = true
+		if config.docLineTypes != nil {
+			config.docLineTypes[id] = fileLineType
+		}
+	}
+	summary.Documents = len(ids)
+	if err := scanner.Err(); err != nil {
+		return summary, fmt.Errorf("error reading file %s: %w", fileName, err)
+	}
+	return summary, nil
+}
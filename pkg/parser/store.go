@@ -0,0 +1,244 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+/*
+A DocumentStore holds the parsed documents, indexed by document id. The parser only talks to the documents
+through a DocumentStore, so parsing, looking up existing documents (i.e. in a database) and writing the output
+all use the same abstraction. A document is a map[string]interface{} with the metadata and header fields and a
+"data" section, which is a map of the concrete line type data indexed by the dataKey.
+
+Implementations must be safe for concurrent use.
+*/
+type DocumentStore interface {
+	// Get returns the document for the id, or an error wrapping ErrDocNotFound if there is no document for the id.
+	Get(id string) (map[string]interface{}, error)
+	// Put stores the document for the id, replacing any existing document.
+	Put(id string, doc map[string]interface{}) error
+	// Merge adds the data section of doc to the document for the id, replacing data with the same dataKey,
+	// or stores doc if there is no document for the id.
+	Merge(id string, doc map[string]interface{}) error
+	// Range calls fn for every document until fn returns false.
+	Range(fn func(id string, doc map[string]interface{}) bool) error
+	// Len returns the number of documents.
+	Len() int
+}
+
+/*
+mergeLocker is a DocumentStore with a lock for the collision checks and the merges of its documents, see
+mergeDocument. The stores of this package are mergeLockers, so the lines that go to different stores, i.e. the
+private stores of the ParseDirectory workers, do not wait for each other.
+*/
+type mergeLocker interface {
+	mergeLock() *sync.Mutex
+}
+
+// MemoryStore is a DocumentStore that keeps the documents in a map.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	mergeMu sync.Mutex // held across the collision check and the merge of a document
+	docs    map[string]interface{}
+}
+
+/*
+NewMemoryStore returns a MemoryStore that keeps the documents in docs, which may be nil. This lets
+a MemoryStore be used with an existing document map like the one that ParseLine takes.
+*/
+func NewMemoryStore(docs map[string]interface{}) *MemoryStore {
+	if docs == nil {
+		docs = make(map[string]interface{})
+	}
+	return &MemoryStore{docs: docs}
+}
+
+func (s *MemoryStore) mergeLock() *sync.Mutex {
+	return &s.mergeMu
+}
+
+func (s *MemoryStore) Get(id string) (map[string]interface{}, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	doc, ok := s.docs[id].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrDocNotFound, id)
+	}
+	return doc, nil
+}
+
+func (s *MemoryStore) Put(id string, doc map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[id] = doc
+	return nil
+}
+
+func (s *MemoryStore) Merge(id string, doc map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[id] = mergeDoc(s.docs[id], doc)
+	return nil
+}
+
+/*
+Range calls fn with a snapshot of the documents, so fn may use the store, i.e. to Put a document.
+*/
+func (s *MemoryStore) Range(fn func(id string, doc map[string]interface{}) bool) error {
+	s.mu.RLock()
+	ids := make([]string, 0, len(s.docs))
+	docs := make([]map[string]interface{}, 0, len(s.docs))
+	for id, doc := range s.docs {
+		if docMap, ok := doc.(map[string]interface{}); ok {
+			ids = append(ids, id)
+			docs = append(docs, docMap)
+		}
+	}
+	s.mu.RUnlock()
+	for i, id := range ids {
+		if !fn(id, docs[i]) {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.docs)
+}
+
+/*
+Documents returns the map that the documents are kept in. It must not be used while the store is still being written to.
+*/
+func (s *MemoryStore) Documents() map[string]interface{} {
+	return s.docs
+}
+
+// callbackStore is a DocumentStore that looks up the documents that are not in the local store with a callback.
+type callbackStore struct {
+	mu                  sync.Mutex
+	mergeMu             sync.Mutex // held across the collision check and the merge of a document
+	local               DocumentStore
+	getExternalDocForId func(id string) (map[string]interface{}, error)
+	notFound            map[string]bool // the ids that getExternalDocForId did not find
+}
+
+/*
+NewCallbackStore adapts a getExternalDocForId function, the callback that ParseLine takes, to a DocumentStore.
+The documents are kept in local. When a document is not in local, getExternalDocForId is called to look for an
+existing document (i.e. in a database) and a document that is found is added to local, so the callback is only
+called once per document id. The callback should return an error wrapping ErrDocNotFound if there is no document.
+The callback is never called concurrently, so it does not have to be safe for concurrent use.
+*/
+func NewCallbackStore(local DocumentStore, getExternalDocForId func(id string) (map[string]interface{}, error)) DocumentStore {
+	return &callbackStore{local: local, getExternalDocForId: getExternalDocForId, notFound: make(map[string]bool)}
+}
+
+// load makes sure that an external document for the id is in the local store, the caller holds the lock.
+func (s *callbackStore) load(id string) error {
+	if _, err := s.local.Get(id); err == nil || !isDocNotFound(err) {
+		return err
+	}
+	if s.getExternalDocForId == nil || s.notFound[id] {
+		return nil
+	}
+	externalDoc, err := s.getExternalDocForId(id)
+	if err != nil {
+		if isDocNotFound(err) {
+			s.notFound[id] = true
+			return nil
+		}
+		return fmt.Errorf("error getting external document %s: %w", id, err)
+	}
+	if externalDoc == nil {
+		s.notFound[id] = true
+		return nil
+	}
+	return s.local.Put(id, externalDoc)
+}
+
+func (s *callbackStore) mergeLock() *sync.Mutex {
+	return &s.mergeMu
+}
+
+func (s *callbackStore) Get(id string) (map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(id); err != nil {
+		return nil, err
+	}
+	return s.local.Get(id)
+}
+
+func (s *callbackStore) Put(id string, doc map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.local.Put(id, doc)
+}
+
+func (s *callbackStore) Merge(id string, doc map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.load(id); err != nil {
+		return err
+	}
+	return s.local.Merge(id, doc)
+}
+
+// Range only ranges over the local documents.
+func (s *callbackStore) Range(fn func(id string, doc map[string]interface{}) bool) error {
+	return s.local.Range(fn)
+}
+
+// Len only counts the local documents.
+func (s *callbackStore) Len() int {
+	return s.local.Len()
+}
+
+/*
+mergeDoc merges the document src into the document dst and returns the merged document. If dst is not a document
+src is returned, otherwise the data entries of src are added to the data of dst, replacing any entries with the same dataKey.
+*/
+func mergeDoc(dst interface{}, src map[string]interface{}) map[string]interface{} {
+	dstDoc, ok := dst.(map[string]interface{})
+	if !ok {
+		return src
+	}
+	dstDoc["data"] = mergeData(dstDoc["data"], src["data"])
+	return dstDoc
+}
+
+/*
+mergeData merges two data sections. The data sections are normally maps of the same concrete line type
+i.e. map[string]STAT_CNT, but a document that came from getExternalDocForId may have a map[string]interface{}
+data section, in which case the merged data section is a map[string]interface{}.
+*/
+func mergeData(dstData interface{}, srcData interface{}) interface{} {
+	dst := reflect.ValueOf(dstData)
+	src := reflect.ValueOf(srcData)
+	if !dst.IsValid() || dst.Kind() != reflect.Map || dst.IsNil() {
+		return srcData
+	}
+	if !src.IsValid() || src.Kind() != reflect.Map {
+		return dstData
+	}
+	if src.Type() == dst.Type() {
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return dstData
+	}
+	merged := make(map[string]interface{}, dst.Len()+src.Len())
+	for _, m := range []reflect.Value{dst, src} {
+		iter := m.MapRange()
+		for iter.Next() {
+			merged[fmt.Sprint(iter.Key().Interface())] = iter.Value().Interface()
+		}
+	}
+	return merged
+}
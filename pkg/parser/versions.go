@@ -0,0 +1,230 @@
+package parser
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/NOAA-GSL/METstat2json/pkg/engine"
+	"github.com/NOAA-GSL/METstat2json/pkg/util"
+)
+
+/*
+The MET version of a data line, i.e. V12.0.0, selects the generated linetypes package that the line is parsed
+with, i.e. v12_0. MET minor and patch releases rarely change the column layout, so a version that does not have
+a linetypes package (i.e. V12.1.0) is parsed with the package of the nearest older version of the same major
+release (v12_0), as long as the columns of the file match the column definitions of that package: the header
+columns, and the data columns too if the header line names them (i.e. the header of a _mpr.txt or a .tcst file).
+A version of another major release (i.e. V13.0.0) is never parsed with an older one. Every fallback is reported to
+the warning handler. The VersionFallback policy of a Parser decides what happens when the columns do not match, and
+WithPinnedVersion maps a version to a package explicitly.
+*/
+
+// VersionFallback is the policy for the MET versions that do not have a linetypes package.
+type VersionFallback int
+
+const (
+	// FallbackCompatible parses a line with the nearest older supported version of the same major release if the columns
+	// of the file match the column definitions of that version, and rejects the line with an ErrIncompatibleVersion error otherwise. This is the default.
+	FallbackCompatible VersionFallback = iota
+	// FallbackNearest always parses a line with the nearest older supported version of the same major release, whether the columns match or not.
+	FallbackNearest
+	// FallbackNever rejects the lines of every version that does not have a linetypes package with an ErrUnsupportedVersion error.
+	FallbackNever
+)
+
+// WithVersionFallback sets the policy for the MET versions that do not have a linetypes package, the default is FallbackCompatible.
+func WithVersionFallback(policy VersionFallback) Option {
+	return func(p *Parser) {
+		p.versionFallback = policy
+	}
+}
+
+/*
+WithPinnedVersion parses the lines of a MET version with the linetypes package of another version, without checking
+the header columns, i.e. WithPinnedVersion("v12_1", "v12_0"). The versions are parser versions, see SupportedVersions.
+*/
+func WithPinnedVersion(version string, parserVersion string) Option {
+	return func(p *Parser) {
+		if p.pinnedVersions == nil {
+			p.pinnedVersions = make(map[string]string)
+		}
+		p.pinnedVersions[version] = parserVersion
+	}
+}
+
+/*
+WithTableDrivenLineTypes parses the lines with the engine package instead of the generated linetypes packages.
+The engine builds the line types of a MET version at runtime from the embedded column definitions and column types
+tables, see the engine package for how its documents differ. The version of a line is chosen the same way,
+the engine parses it as the version of the linetypes package that would have been used.
+*/
+func WithTableDrivenLineTypes() Option {
+	return func(p *Parser) {
+		p.tableDriven = true
+	}
+}
+
+/*
+WithWarningHandler sets the function that is called with the warnings of the parser, i.e. when a version falls back
+to an older version. A warning is only issued once for each version, line type and header line.
+The default handler writes the warnings with the log package, a nil handler discards them.
+*/
+func WithWarningHandler(fn func(warning error)) Option {
+	return func(p *Parser) {
+		p.warningHandler = fn
+	}
+}
+
+// logWarning is the default warning handler.
+func logWarning(warning error) {
+	log.Printf("METstat2json warning: %v", warning)
+}
+
+/*
+SupportedVersions returns the parser versions i.e. v12_0 of the MET releases that the parser supports, oldest first.
+*/
+func SupportedVersions() []string {
+	return util.LineTypeSetVersions()
+}
+
+// versionFallbackKey identifies the lines that a version fallback applies to.
+type versionFallbackKey struct {
+	version      string
+	fileLineType string
+	headerLine   string
+}
+
+// versionFallbackResult is the LineTypeSet, or the error, for the lines of a versionFallbackKey.
+type versionFallbackResult struct {
+	set     util.LineTypeSet
+	err     error
+	warning error
+}
+
+/*
+lineTypeSetFor returns the LineTypeSet that a data line of the parser version and line type is parsed with,
+see util.RegisterCustomLineType for the custom line types and WithGrouping for the line types with a grouping of their own.
+The result of a fallback is cached so the header columns are only compared once for each header line.
+*/
+func (p *Parser) lineTypeSetFor(parserVersion string, header *util.CompiledHeader, fileLineType string) (util.LineTypeSet, error) {
+	set, err := p.versionLineTypeSetFor(parserVersion, header, fileLineType)
+	if err != nil {
+		return set, err
+	}
+	if _, ok := p.groupings[fileLineType]; !ok {
+		return set, nil
+	}
+	return p.groupedLineTypeSet(set.Version(), fileLineType)
+}
+
+// versionLineTypeSetFor returns the LineTypeSet of the version that a data line is parsed with, whatever the grouping of its line type is.
+func (p *Parser) versionLineTypeSetFor(parserVersion string, header *util.CompiledHeader, fileLineType string) (util.LineTypeSet, error) {
+	if util.IsCustomLineType(fileLineType) {
+		// the custom line types are parsed by the engine, whatever the version of the line is
+		return engine.Custom(), nil
+	}
+	set, err := p.generatedLineTypeSetFor(parserVersion, header, fileLineType)
+	if err != nil || !p.tableDriven {
+		return set, err
+	}
+	return engine.Get(set.Version())
+}
+
+// generatedLineTypeSetFor returns the LineTypeSet of the generated linetypes package that a data line is parsed with.
+func (p *Parser) generatedLineTypeSetFor(parserVersion string, header *util.CompiledHeader, fileLineType string) (util.LineTypeSet, error) {
+	if pinned, ok := p.pinnedVersions[parserVersion]; ok {
+		return util.GetLineTypeSet(pinned)
+	}
+	set, err := util.GetLineTypeSet(parserVersion)
+	if err == nil || p.versionFallback == FallbackNever {
+		return set, err
+	}
+	key := versionFallbackKey{version: parserVersion, fileLineType: fileLineType, headerLine: header.HeaderLine}
+	if cached, ok := p.fallbacks.Load(key); ok {
+		result := cached.(versionFallbackResult)
+		return result.set, result.err
+	}
+	result := p.fallbackLineTypeSet(parserVersion, header, fileLineType)
+	if _, loaded := p.fallbacks.LoadOrStore(key, result); !loaded && result.warning != nil && p.warningHandler != nil {
+		p.warningHandler(result.warning)
+	}
+	return result.set, result.err
+}
+
+/*
+fallbackLineTypeSet finds the nearest older supported version of the same major release for a version that has no
+linetypes package and checks the columns of the file against it, see fallbackColumns. The result always has a warning.
+*/
+func (p *Parser) fallbackLineTypeSet(parserVersion string, header *util.CompiledHeader, fileLineType string) versionFallbackResult {
+	set, err := util.NearestLineTypeSet(parserVersion)
+	if err != nil {
+		return versionFallbackResult{err: err}
+	}
+	section, column, ok := fallbackColumns(set, header, fileLineType)
+	if ok {
+		warning := fmt.Errorf("%w %s: the %s lines of %s are parsed with version %s, the columns match",
+			ErrUnsupportedVersion, parserVersion, fileLineType, header.FileName, set.Version())
+		return versionFallbackResult{set: set, warning: warning}
+	}
+	err = fmt.Errorf("%w %s: %w: the %s %s columns of %s do not match version %s at column %s",
+		ErrUnsupportedVersion, parserVersion, ErrIncompatibleVersion, fileLineType, section, header.FileName, set.Version(), column)
+	if p.versionFallback == FallbackNearest {
+		return versionFallbackResult{set: set, warning: err}
+	}
+	return versionFallbackResult{err: err, warning: err}
+}
+
+/*
+fallbackColumns compares the columns of a header line with the columns of a line type in the version that it falls
+back to. The header columns are always compared, the data columns only if the header line names them, up to the first
+repeated sequence, whose columns are only known from the line. It returns true if they match, otherwise the section
+("header" or "data") and the first column that does not match.
+*/
+func fallbackColumns(set util.LineTypeSet, header *util.CompiledHeader, fileLineType string) (string, string, bool) {
+	if column, ok := compareColumns(set.HeaderColumns(fileLineType), header.HeaderStringFields); !ok {
+		return "header", column, false
+	}
+	fileDataColumns := header.Fields[min(len(header.HeaderStringFields), len(header.Fields)):]
+	if len(fileDataColumns) == 0 {
+		// i.e. a .stat header line that ends at LINE_TYPE
+		return "", "", true
+	}
+	_, dataColumns, err := util.LineTypeColumns(set.Version(), fileLineType)
+	if err != nil {
+		// an unknown line type is reported when the line is parsed
+		return "", "", true
+	}
+	if index := slices.IndexFunc(dataColumns, isRepeatedColumn); index >= 0 {
+		dataColumns = dataColumns[:index]
+		fileDataColumns = fileDataColumns[:min(index, len(fileDataColumns))]
+	}
+	if len(dataColumns) == 0 {
+		return "", "", true
+	}
+	if column, ok := compareColumns(dataColumns, fileDataColumns); !ok {
+		return "data", column, false
+	}
+	return "", "", true
+}
+
+// compareColumns returns true if the columns are the expected columns, otherwise the first column that does not match.
+func compareColumns(expected []string, columns []string) (string, bool) {
+	for i := range max(len(expected), len(columns)) {
+		switch {
+		case i >= len(columns):
+			return expected[i], false
+		case i >= len(expected):
+			return columns[i], false
+		case expected[i] != columns[i] && !isUserColumn(expected[i], columns[i]):
+			return columns[i], false
+		}
+	}
+	return "", len(expected) > 0
+}
+
+// isUserColumn returns true if a column of a file is the column of a line type whose name is a user setting, see userColumns.
+func isUserColumn(expected string, column string) bool {
+	pattern, ok := userColumns[expected]
+	return ok && pattern.MatchString(column)
+}
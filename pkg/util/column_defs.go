@@ -0,0 +1,291 @@
+package util
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+/*
+The met_header_columns_V*.txt tables of the supported MET versions are embedded in the package, so that finding
+the line type of a header line never needs the network or the filesystem. The tables are in the column_defs
+directory, one file per version. They are not copies of the files of MetHeaderColumnsFileUrls: they were rebuilt
+from the header columns and the data structs of the generated linetypes packages, in the format of the upstream
+files, so their lines are in the order of the packages, they only have the line types that the packages have and
+their repeated sequences are written the way the generator reads them. TestColumnDefsMatchLineTypes in the parser
+package checks that the tables and the packages agree. To vendor an upstream table instead, download it into the
+column_defs directory and run the tests, i.e.
+
+	curl -o pkg/util/column_defs/met_header_columns_V12.0.txt $MetHeaderColumnsFileUrl_v12_0
+
+The column_types_V*.json tables next to them have the types of the columns and the repeated sequences of
+every version, the generator writes them with the linetypes packages. With both tables a line type can be
+parsed without generated code, see the engine package.
+*/
+
+//go:embed column_defs/met_header_columns_V*.txt column_defs/column_types_V*.json
+var columnDefsFS embed.FS
+
+var (
+	columnDefsOnce sync.Once
+	columnDefs     map[string][]string
+)
+
+// loadColumnDefs reads the embedded tables into the columnDefs map, by parser version.
+func loadColumnDefs() {
+	columnDefs = make(map[string][]string)
+	entries, _ := columnDefsFS.ReadDir("column_defs")
+	for _, entry := range entries {
+		version, ok := columnDefsVersion(entry.Name())
+		if !ok {
+			continue
+		}
+		content, err := columnDefsFS.ReadFile("column_defs/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		var lines []string
+		for _, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+		columnDefs[version] = lines
+	}
+}
+
+// columnDefsVersion returns the parser version i.e. v12_0 of a table file name i.e. met_header_columns_V12.0.txt.
+func columnDefsVersion(fileName string) (string, bool) {
+	return tableVersion(fileName, "met_header_columns_V", ".txt")
+}
+
+// tableVersion returns the parser version i.e. v12_0 of a table file name with a prefix and a suffix.
+func tableVersion(fileName string, prefix string, suffix string) (string, bool) {
+	version, found := strings.CutPrefix(fileName, prefix)
+	if !found {
+		return "", false
+	}
+	version, found = strings.CutSuffix(version, suffix)
+	if !found {
+		return "", false
+	}
+	return "v" + strings.ReplaceAll(version, ".", "_"), true
+}
+
+/*
+ColumnDefs returns the lines of the embedded met_header_columns table of a parser version i.e. v12_0,
+or an ErrUnsupportedVersion error if there is no table for the version. A line has the form
+
+	V12.0 : STAT : CNT : VERSION MODEL DESC ... LINE_TYPE TOTAL FBAR ...
+
+The returned slice is a copy and can be changed by the caller.
+*/
+func ColumnDefs(version string) ([]string, error) {
+	columnDefsOnce.Do(loadColumnDefs)
+	lines, ok := columnDefs[version]
+	if !ok {
+		return nil, fmt.Errorf("%w %s: no column definitions", ErrUnsupportedVersion, version)
+	}
+	return slices.Clone(lines), nil
+}
+
+/*
+SplitColumnDefsLine returns the file type, the line type and the columns of a line of a met_header_columns table, i.e.
+STAT, CNT and "VERSION MODEL ..." for "V12.0 : STAT : CNT : VERSION MODEL ...".
+*/
+func SplitColumnDefsLine(line string) (string, string, string, bool) {
+	prefix, columns, found := strings.Cut(line, ": VERSION")
+	if !found {
+		return "", "", "", false
+	}
+	parts := strings.Split(prefix, " : ")
+	if len(parts) < 3 {
+		return "", "", "", false
+	}
+	fileType := strings.ToUpper(strings.TrimSpace(parts[1]))
+	lineType := strings.ToUpper(strings.TrimSpace(parts[2]))
+	return fileType, lineType, "VERSION" + columns, true
+}
+
+/*
+LineTypeColumns returns the header and data columns of a fileLineType i.e. STAT_CNT in the met_header_columns table
+of a parser version, or of a custom line type. A line type that is defined twice has the columns of its last
+definition, like the generated code. The column spellings of the column types table of the version are replaced
+by the columns they stand for, i.e. the data columns of STAT_ORANK have RANK. It returns an ErrUnknownLineType error for a line type that is not in the table.
+*/
+func LineTypeColumns(version string, fileLineType string) ([]string, []string, error) {
+	if custom, ok := GetCustomLineType(fileLineType); ok {
+		headerColumns, dataColumns := custom.Split()
+		return headerColumns, dataColumns, nil
+	}
+	lines, err := ColumnDefs(version)
+	if err != nil {
+		return nil, nil, err
+	}
+	var columns string
+	for _, line := range lines {
+		fileType, lineType, lineColumns, ok := SplitColumnDefsLine(line)
+		if ok && fileType+"_"+lineType == fileLineType {
+			columns = lineColumns
+		}
+	}
+	if columns == "" {
+		return nil, nil, fmt.Errorf("%w %s in version %s", ErrUnknownLineType, fileLineType, version)
+	}
+	headerColumns, dataColumns := SplitColumnDefLine(fileLineType, columns)
+	if table, err := ColumnTypes(version); err == nil {
+		dataColumns = RespellColumns(table.ColumnSpellings, fileLineType, dataColumns)
+	}
+	return headerColumns, dataColumns, nil
+}
+
+// ColumnDefsVersions returns the parser versions of the embedded met_header_columns tables, oldest first.
+func ColumnDefsVersions() []string {
+	columnDefsOnce.Do(loadColumnDefs)
+	versions := make([]string, 0, len(columnDefs))
+	for version := range columnDefs {
+		versions = append(versions, version)
+	}
+	SortVersions(versions)
+	return versions
+}
+
+/*
+A ColumnTypeTable has the types of the columns of the line types of a MET version, i.e. "FBAR": "float64",
+the repeated sequences of columns that start with an (N_*) column and the spellings of columns in the
+met_header_columns table that stand for other columns. A column that is not in ColumnTypes is a string.
+The types of the header columns are in the table too, the data key columns are not.
+*/
+type ColumnTypeTable struct {
+	Version         string            `json:"version"`
+	ColumnTypes     map[string]string `json:"columnTypes"`
+	RepeatingGroups []RepeatingGroup  `json:"repeatingGroups"`
+	ColumnSpellings []ColumnSpelling  `json:"columnSpellings,omitempty"`
+}
+
+/*
+A ColumnSpelling is a sequence of columns of the met_header_columns table that stands for one column of some line
+types, i.e. "(N_RANK) RANK_[0-9]*" for the single RANK column of STAT_ORANK lines.
+*/
+type ColumnSpelling struct {
+	LineTypes []string `json:"lineTypes"`
+	Columns   []string `json:"columns"`
+	Column    string   `json:"column"`
+}
+
+// RespellColumns returns the columns of a fileLineType with the spellings that stand for another column replaced by that column.
+func RespellColumns(spellings []ColumnSpelling, fileLineType string, columns []string) []string {
+	for _, spelling := range spellings {
+		if !slices.Contains(spelling.LineTypes, fileLineType) || len(spelling.Columns) == 0 {
+			continue
+		}
+		for i := 0; i+len(spelling.Columns) <= len(columns); i++ {
+			if slices.Equal(columns[i:i+len(spelling.Columns)], spelling.Columns) {
+				columns = slices.Concat(columns[:i], []string{spelling.Column}, columns[i+len(spelling.Columns):])
+			}
+		}
+	}
+	return columns
+}
+
+/*
+A RepeatingGroup is an (N_*) column, i.e. (N_THRESH), that is followed by a sequence of columns whose length
+is given by the value of the (N_*) column. The values of the sequence are in a map field of the data, the
+StructField, keyed by the key prefixes and the number of the value in the sequence, i.e. THRESH_1 OY_1 ON_1.
+*/
+type RepeatingGroup struct {
+	Term        string          `json:"term"`
+	CountType   string          `json:"countType"`
+	StructField string          `json:"structField"`
+	Layout      string          `json:"layout,omitempty"` // "" is a sequence, "matrix" is an N_CAT x N_CAT table
+	Sequences   []GroupSequence `json:"sequences"`
+}
+
+// A GroupSequence is the sequence of a RepeatingGroup for some line types, or for any line type if LineTypes is empty.
+type GroupSequence struct {
+	LineTypes   []string `json:"lineTypes,omitempty"`
+	KeyPrefixes []string `json:"keyPrefixes"`
+	ElementType string   `json:"elementType"`
+}
+
+// FindRepeatingGroup returns the repeating group of an (N_*) term and its sequence for a fileLineType.
+func FindRepeatingGroup(groups []RepeatingGroup, term string, fileLineType string) (RepeatingGroup, GroupSequence, bool) {
+	for _, group := range groups {
+		if group.Term != term {
+			continue
+		}
+		for _, sequence := range group.Sequences {
+			if len(sequence.LineTypes) == 0 || slices.Contains(sequence.LineTypes, fileLineType) {
+				return group, sequence, true
+			}
+		}
+	}
+	return RepeatingGroup{}, GroupSequence{}, false
+}
+
+// ColumnType returns the type of a column, "int", "float64" or "string".
+func (t *ColumnTypeTable) ColumnType(column string) string {
+	if columnType, ok := t.ColumnTypes[column]; ok {
+		return columnType
+	}
+	return "string"
+}
+
+// ColumnTypesFileName returns the name of the column types table of a parser version i.e. column_types_V12.0.json for v12_0.
+func ColumnTypesFileName(version string) string {
+	return "column_types_V" + strings.ReplaceAll(strings.TrimPrefix(version, "v"), "_", ".") + ".json"
+}
+
+var (
+	columnTypesOnce sync.Once
+	columnTypes     map[string]*ColumnTypeTable
+	columnTypesErr  error
+)
+
+// loadColumnTypes reads the embedded column types tables into the columnTypes map, by parser version.
+func loadColumnTypes() {
+	columnTypes = make(map[string]*ColumnTypeTable)
+	entries, _ := columnDefsFS.ReadDir("column_defs")
+	for _, entry := range entries {
+		version, ok := tableVersion(entry.Name(), "column_types_V", ".json")
+		if !ok {
+			continue
+		}
+		content, err := columnDefsFS.ReadFile("column_defs/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		var table ColumnTypeTable
+		if err := json.Unmarshal(content, &table); err != nil {
+			columnTypesErr = fmt.Errorf("error in the column types table %s: %w", entry.Name(), err)
+			return
+		}
+		columnTypes[version] = &table
+	}
+}
+
+/*
+ColumnTypes returns the embedded column types table of a parser version i.e. v12_0, or an ErrUnsupportedVersion
+error if there is no table for the version. The returned table is a copy and can be changed by the caller.
+*/
+func ColumnTypes(version string) (*ColumnTypeTable, error) {
+	columnTypesOnce.Do(loadColumnTypes)
+	if columnTypesErr != nil {
+		return nil, columnTypesErr
+	}
+	table, ok := columnTypes[version]
+	if !ok {
+		return nil, fmt.Errorf("%w %s: no column types", ErrUnsupportedVersion, version)
+	}
+	return &ColumnTypeTable{
+		Version:         table.Version,
+		ColumnTypes:     maps.Clone(table.ColumnTypes),
+		RepeatingGroups: slices.Clone(table.RepeatingGroups),
+		ColumnSpellings: slices.Clone(table.ColumnSpellings),
+	}, nil
+}
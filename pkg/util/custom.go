@@ -0,0 +1,213 @@
+package util
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+/*
+A CustomLineType is a line type that is not in the MET column definitions, i.e. the STAT like lines that a custom
+tool or a python embedding job writes. The lines have the columns of a met_header_columns line: the header columns,
+which start with VERSION and end with the SeparatorField, and the data columns. A custom line type is registered with
+RegisterCustomLineType, which has the engine package build its layout, and the lines are then parsed by the engine
+package like the lines of a built in line type.
+*/
+type CustomLineType struct {
+	// FileType is the file type of the lines i.e. STAT, the fileLineType of the lines is FileType_LineType
+	FileType string
+	// LineType is the LINE_TYPE value of the lines i.e. MY_SCORES, or the name of the line type if the SeparatorField is not LINE_TYPE
+	LineType string
+	// Columns are the header and data columns like in a met_header_columns file, i.e. "VERSION MODEL ... LINE_TYPE TOTAL (N_THRESH) THRESH_[0-9]*"
+	Columns string
+	// SeparatorField is the last header column, LINE_TYPE if it is empty
+	SeparatorField string
+	// ColumnTypes are the types of the columns by field name i.e. "FBAR": "float64", the other columns are strings
+	// except for the int and date header fields, see IntFieldNames and DateFieldNames
+	ColumnTypes map[string]string
+	// RepeatingGroups are the repeated sequences of the data columns that start with an (N_*) column
+	RepeatingGroups []RepeatingGroup
+	// DataKey is the DataKeyMap entry of the fileLineType
+	DataKey DataKeyEntry
+}
+
+var (
+	// customLineTypesMu guards the custom line types and the DataKeyMap, which RegisterCustomLineType adds to
+	customLineTypesMu sync.RWMutex
+	customLineTypes   = make(map[string]CustomLineType)
+	// addCustomLayout builds and adds the layout of a custom line type, see RegisterCustomLayouts
+	addCustomLayout func(CustomLineType) error
+)
+
+// lineTypeNamePattern matches the file types and line types, i.e. STAT and MY_SCORES.
+var lineTypeNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// FileLineType returns the fileLineType of a custom line type, i.e. STAT_MY_SCORES.
+func (c CustomLineType) FileLineType() string {
+	return c.FileType + "_" + c.LineType
+}
+
+// Separator returns the separator field of a custom line type, LINE_TYPE by default.
+func (c CustomLineType) Separator() string {
+	if c.SeparatorField == "" {
+		return "LINE_TYPE"
+	}
+	return c.SeparatorField
+}
+
+// Split returns the header columns, up to and including the separator field, and the data columns of a custom line type.
+func (c CustomLineType) Split() ([]string, []string) {
+	columns := strings.Fields(c.Columns)
+	index := slices.Index(columns, c.Separator())
+	if index < 0 {
+		return columns, nil
+	}
+	return columns[:index+1], columns[index+1:]
+}
+
+// Validate returns an ErrInvalidLineType error if the lines of a custom line type cannot be parsed.
+func (c CustomLineType) Validate() error {
+	fileLineType := c.FileLineType()
+	if !lineTypeNamePattern.MatchString(c.FileType) || !lineTypeNamePattern.MatchString(c.LineType) {
+		return fmt.Errorf("%w %q: the file type and the line type are upper case names", ErrInvalidLineType, fileLineType)
+	}
+	header, data := c.Split()
+	if len(header) == 0 || header[0] != "VERSION" {
+		return fmt.Errorf("%w %s: the first column is not VERSION", ErrInvalidLineType, fileLineType)
+	}
+	if header[len(header)-1] != c.Separator() {
+		return fmt.Errorf("%w %s: the separator field %s is not a column", ErrInvalidLineType, fileLineType, c.Separator())
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("%w %s: there are no data columns", ErrInvalidLineType, fileLineType)
+	}
+	if len(c.DataKey.DataKey) == 0 {
+		return fmt.Errorf("%w %s: there is no data key", ErrInvalidLineType, fileLineType)
+	}
+	for _, column := range c.DataKey.DataKey {
+		if !slices.Contains(header, column) && !slices.Contains(data, column) {
+			return fmt.Errorf("%w %s: the data key %s is not a column", ErrInvalidLineType, fileLineType, column)
+		}
+	}
+	for _, column := range c.DataKey.HeaderDisallow {
+		if !slices.Contains(header, column) {
+			return fmt.Errorf("%w %s: the disallowed header field %s is not a header column", ErrInvalidLineType, fileLineType, column)
+		}
+	}
+	for column, columnType := range c.ColumnTypes {
+		if columnType != "int" && columnType != "float64" && columnType != "string" {
+			return fmt.Errorf("%w %s: the column %s has the unknown type %q", ErrInvalidLineType, fileLineType, column, columnType)
+		}
+	}
+	for _, group := range c.RepeatingGroups {
+		if !slices.Contains(data, group.Term) {
+			return fmt.Errorf("%w %s: the repeating group %s is not a data column", ErrInvalidLineType, fileLineType, group.Term)
+		}
+	}
+	return nil
+}
+
+/*
+RegisterCustomLayouts sets the function that builds the layout of a custom line type and adds it to the line types
+that the custom lines are parsed with. The engine package calls it in an init function. It panics if it is called
+twice, like RegisterLineTypeSet.
+*/
+func RegisterCustomLayouts(add func(CustomLineType) error) {
+	customLineTypesMu.Lock()
+	defer customLineTypesMu.Unlock()
+	if addCustomLayout != nil {
+		panic("util: RegisterCustomLayouts called twice")
+	}
+	addCustomLayout = add
+}
+
+/*
+RegisterCustomLineType adds a custom line type to the line types that the header lines and the data lines are
+checked against, its DataKey to DataKeyMap and its layout to the engine package, which parses its lines. It returns
+an ErrInvalidLineType error for a line type that does not validate, whose layout cannot be built or that is already
+a MET or custom line type. The DataKeyMap is written under a lock, so a line type can be registered while lines are
+parsed, as long as the DataKeyMap is read with GetDataKeyEntry. The layout is added under the same lock, so a line
+that finds the registered line type finds its layout.
+*/
+func RegisterCustomLineType(c CustomLineType) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	customLineTypesMu.Lock()
+	defer customLineTypesMu.Unlock()
+	fileLineType := c.FileLineType()
+	if _, ok := DataKeyMap[fileLineType]; ok {
+		return fmt.Errorf("%w %s: the line type is already defined", ErrInvalidLineType, fileLineType)
+	}
+	if addCustomLayout == nil {
+		return fmt.Errorf("%w %s: there is no engine to parse the line type, import the engine package", ErrInvalidLineType, fileLineType)
+	}
+	if err := addCustomLayout(c); err != nil {
+		return err
+	}
+	c.ColumnTypes = maps.Clone(c.ColumnTypes)
+	c.RepeatingGroups = slices.Clone(c.RepeatingGroups)
+	customLineTypes[fileLineType] = c
+	DataKeyMap[fileLineType] = c.DataKey
+	return nil
+}
+
+/*
+GetDataKeyEntry returns the DataKeyMap entry of a fileLineType i.e. STAT_CNT, and false if the fileLineType has none.
+It reads the DataKeyMap under the lock of RegisterCustomLineType, so it can be called while line types are registered.
+*/
+func GetDataKeyEntry(fileLineType string) (DataKeyEntry, bool) {
+	customLineTypesMu.RLock()
+	defer customLineTypesMu.RUnlock()
+	entry, ok := DataKeyMap[fileLineType]
+	return entry, ok
+}
+
+// GetCustomLineType returns the registered custom line type of a fileLineType i.e. STAT_MY_SCORES.
+func GetCustomLineType(fileLineType string) (CustomLineType, bool) {
+	customLineTypesMu.RLock()
+	defer customLineTypesMu.RUnlock()
+	c, ok := customLineTypes[fileLineType]
+	return c, ok
+}
+
+// IsCustomLineType returns true if a fileLineType is a registered custom line type.
+func IsCustomLineType(fileLineType string) bool {
+	_, ok := GetCustomLineType(fileLineType)
+	return ok
+}
+
+// CustomLineTypes returns the fileLineTypes of the registered custom line types, in sorted order.
+func CustomLineTypes() []string {
+	customLineTypesMu.RLock()
+	fileLineTypes := make([]string, 0, len(customLineTypes))
+	for fileLineType := range customLineTypes {
+		fileLineTypes = append(fileLineTypes, fileLineType)
+	}
+	customLineTypesMu.RUnlock()
+	slices.Sort(fileLineTypes)
+	return fileLineTypes
+}
+
+/*
+getCustomLineTypeForHeader returns the custom line type whose header columns a header line starts with, for the
+files whose names do not tell their line type. If more than one custom line type matches, the one with the most
+header columns is returned, and the first of them in sorted order if they have the same number.
+*/
+func getCustomLineTypeForHeader(headerLine string) (CustomLineType, bool) {
+	headerFields := strings.Fields(headerLine)
+	var found CustomLineType
+	foundColumns := 0
+	for _, fileLineType := range CustomLineTypes() {
+		c, _ := GetCustomLineType(fileLineType)
+		header, _ := c.Split()
+		if len(header) > foundColumns && len(headerFields) >= len(header) && slices.Equal(headerFields[:len(header)], header) {
+			found = c
+			foundColumns = len(header)
+		}
+	}
+	return found, foundColumns > 0
+}
@@ -0,0 +1,142 @@
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+A LineTypeSet is the generated code for the line types of one MET version, see the pkg/linetypes packages.
+Every generated linetypes package registers its LineTypeSet with RegisterLineTypeSet in an init function,
+and the parser finds the LineTypeSet for the version of a data line with GetLineTypeSet. Supporting
+a new MET release means adding its met_header_columns file to MetHeaderColumnsFileUrls, generating
+its linetypes package and importing that package in the parser.
+*/
+type LineTypeSet interface {
+	// Version returns the parser version of the line types i.e. v12_0
+	Version() string
+	// GetDocForId returns a new document with the header and the data of one data line
+	GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error)
+	// AddDataElement adds the data of one data line to an existing document
+	AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error)
+	// ColumnDefsUrl returns the URL of the met_header_columns file that the line types were generated from
+	ColumnDefsUrl() string
+	// LineTypes returns the supported fileLineTypes i.e. STAT_CNT, in sorted order
+	LineTypes() []string
+	// HeaderColumns returns the header columns of a fileLineType in the met_header_columns file, or nil for an unknown fileLineType
+	HeaderColumns(fileLineType string) []string
+}
+
+// MetHeaderColumnsFileUrls are the met_header_columns files of the MET versions that can be generated, by parser version.
+var MetHeaderColumnsFileUrls = map[string]string{
+	"v12_0": MetHeaderColumnsFileUrl_v12_0,
+	"v11_1": MetHeaderColumnsFileUrl_v11_1,
+	"v11_0": MetHeaderColumnsFileUrl_v11_0,
+	"v10_1": MetHeaderColumnsFileUrl_v10_1,
+	"v10_0": MetHeaderColumnsFileUrl_v10_0,
+}
+
+var (
+	lineTypeSetsMu sync.RWMutex
+	lineTypeSets   = make(map[string]LineTypeSet)
+)
+
+/*
+RegisterLineTypeSet makes the line types of a MET version available to the parser.
+It panics if a LineTypeSet for the same version is already registered, like database/sql.Register does for drivers.
+*/
+func RegisterLineTypeSet(set LineTypeSet) {
+	lineTypeSetsMu.Lock()
+	defer lineTypeSetsMu.Unlock()
+	version := set.Version()
+	if _, ok := lineTypeSets[version]; ok {
+		panic("util: RegisterLineTypeSet called twice for version " + version)
+	}
+	lineTypeSets[version] = set
+}
+
+// GetLineTypeSet returns the registered LineTypeSet for a parser version i.e. v12_0, or an ErrUnsupportedVersion error.
+func GetLineTypeSet(version string) (LineTypeSet, error) {
+	lineTypeSetsMu.RLock()
+	defer lineTypeSetsMu.RUnlock()
+	set, ok := lineTypeSets[version]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedVersion, version)
+	}
+	return set, nil
+}
+
+// LineTypeSetVersions returns the parser versions of the registered LineTypeSets, oldest first.
+func LineTypeSetVersions() []string {
+	lineTypeSetsMu.RLock()
+	versions := make([]string, 0, len(lineTypeSets))
+	for version := range lineTypeSets {
+		versions = append(versions, version)
+	}
+	lineTypeSetsMu.RUnlock()
+	SortVersions(versions)
+	return versions
+}
+
+/*
+NearestLineTypeSet returns the registered LineTypeSet of the newest version of the same major release that is not
+newer than version, i.e. the v12_0 LineTypeSet for v12_1, or an ErrUnsupportedVersion error if there is none.
+A major release can change the columns of any line type, so v13_0 does not fall back to v12_0.
+*/
+func NearestLineTypeSet(version string) (LineTypeSet, error) {
+	major, minor, ok := splitVersion(version)
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedVersion, version)
+	}
+	versions := LineTypeSetVersions()
+	for i := len(versions) - 1; i >= 0; i-- {
+		setMajor, setMinor, ok := splitVersion(versions[i])
+		if ok && setMajor == major && setMinor <= minor {
+			return GetLineTypeSet(versions[i])
+		}
+	}
+	return nil, fmt.Errorf("%w %s: there is no older supported version of MET %d", ErrUnsupportedVersion, version, major)
+}
+
+/*
+SortVersions sorts parser versions i.e. v10_1 and v9_0 by their major and minor numbers, oldest first.
+A version that is not in the vMAJOR_MINOR form sorts after the others.
+*/
+func SortVersions(versions []string) {
+	slices.SortFunc(versions, func(a, b string) int {
+		aMajor, aMinor, aOk := splitVersion(a)
+		bMajor, bMinor, bOk := splitVersion(b)
+		switch {
+		case aOk && !bOk:
+			return -1
+		case !aOk && bOk:
+			return 1
+		case !aOk && !bOk:
+			return strings.Compare(a, b)
+		case aMajor != bMajor:
+			return aMajor - bMajor
+		default:
+			return aMinor - bMinor
+		}
+	})
+}
+
+// splitVersion returns the major and minor numbers of a parser version i.e. 12 and 0 for v12_0.
+func splitVersion(version string) (int, int, bool) {
+	majorStr, minorStr, found := strings.Cut(strings.TrimPrefix(version, "v"), "_")
+	if !found || !strings.HasPrefix(version, "v") {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(majorStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err := strconv.Atoi(minorStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
@@ -17,17 +17,29 @@ data fields for a given line type, and to find the data type of a given field in
 */
 
 import (
+	"errors"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
-	"os"
 	"path/filepath"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// These errors are wrapped by the errors that are returned from GetLineType, GetId, and the column definitions lookup
+// so that callers can use errors.Is to tell them apart.
+var (
+	ErrTruncatedLine      = errors.New("truncated line")
+	ErrUnknownLineType    = errors.New("unknown line type")
+	ErrMissingDataKey     = errors.New("missing data key")
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	ErrIdTooLong          = errors.New("id too long")
+	ErrInvalidLineType    = errors.New("invalid line type")
 )
 
 type HeaderFields struct {
@@ -79,12 +91,28 @@ type VxMetadata struct {
 	SubType string `json:"subtype"`
 }
 
+/*
+DataKeyEntry is how the lines of a line type are grouped into documents. The values of the header columns form the
+document id, except for the DataKey columns, whose values form the key of the data of a line in the document, and the
+HeaderDisallow columns, whose values are added to the data instead. IdColumns limits the id to the header columns
+that it names, it is only used by the entries that a parser.Parser is configured with.
+*/
 type DataKeyEntry struct {
 	DataKey        []string
 	HeaderDisallow []string
+	IdColumns      []string // the header columns of the id, all of them if it is empty
+	Separator      string   // joins the values of the DataKey columns, "_" if it is empty
 }
 
-// data key definitions
+/*
+data key definitions, the values of the DataKey columns of a line are joined by "_" to the key of its data in
+the document. The matched pair line types (MPR, SEEPS_MPR, ORANK and GENMPR) have a line for every pair, so
+they are keyed by the pair as well as the lead, i.e. 120000:17:KDEN for the MPR pair with INDEX 17 at OBS_SID KDEN.
+Their keys are joined by ":", so they cannot be mistaken for the _2, _3 suffixes of parser.CollisionKeepAll. Like for
+the other line types a missing key column is left out, i.e. a pair with an NA OBS_SID is keyed 120000:17 by its INDEX.
+A MODE _cts.txt file has a line for every FIELD (RAW and OBJECT) with the same header, so MODE_CTS is keyed by FIELD too.
+RegisterCustomLineType adds the entries of the custom line types, read the map with GetDataKeyEntry while they may be registered.
+*/
 var DataKeyMap = map[string]DataKeyEntry{
 	"STAT_CNT":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_CTC":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
@@ -93,15 +121,15 @@ var DataKeyMap = map[string]DataKeyEntry{
 	"STAT_ISC":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_MCTC":      {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_MCTS":      {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
-	"STAT_MPR":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
+	"STAT_MPR":       {DataKey: []string{"FCST_LEAD", "INDEX", "OBS_SID"}, HeaderDisallow: nil, Separator: ":"},
 	"STAT_SEEPS":     {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
-	"STAT_SEEPS_MPR": {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
+	"STAT_SEEPS_MPR": {DataKey: []string{"FCST_LEAD", "OBS_SID", "OBS_LAT", "OBS_LON"}, HeaderDisallow: nil, Separator: ":"},
 	"STAT_NBRCNT":    {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_NBRCTC":    {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_NBRCTS":    {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_GRAD":      {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_DMAP":      {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
-	"STAT_ORANK":     {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
+	"STAT_ORANK":     {DataKey: []string{"FCST_LEAD", "INDEX", "OBS_SID"}, HeaderDisallow: nil, Separator: ":"},
 	"STAT_PCT":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_PJC":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_PRC":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
@@ -118,10 +146,10 @@ var DataKeyMap = map[string]DataKeyEntry{
 	"STAT_VAL1L2":    {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_VL1L2":     {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"STAT_VCNT":      {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
-	"STAT_GENMPR":    {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
+	"STAT_GENMPR":    {DataKey: []string{"FCST_LEAD", "INDEX", "STORM_ID"}, HeaderDisallow: nil, Separator: ":"},
 	"STAT_SSIDX":     {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
 	"MODE_OBJ":       {DataKey: []string{"FCST_LEAD", "OBJECT_ID"}, HeaderDisallow: nil},
-	"MODE_CTS":       {DataKey: []string{"FCST_LEAD"}, HeaderDisallow: nil},
+	"MODE_CTS":       {DataKey: []string{"FCST_LEAD", "FIELD"}, HeaderDisallow: nil},
 	"MTD_2DSINGLE":   {DataKey: []string{"OBJECT_ID", "TIME_INDEX"}, HeaderDisallow: nil},
 	"MTD_3DSINGLE":   {DataKey: []string{"OBJECT_ID"}, HeaderDisallow: nil},
 	"MTD_3DPAIR":     {DataKey: []string{"OBJECT_ID"}, HeaderDisallow: nil},
@@ -218,12 +246,33 @@ func getLeadFromInitValid(headerFields []string, data []string, dataFieldIndex i
 		headerFields then the DataKey will be "" and the entire header will be used to generate the id.
 */
 func GetLineType(headerLine string, dataLine string, fileName string, version string) (string, []string, []string, string, int, error) {
+	return CompileHeader(headerLine, fileName, version).GetLineType(dataLine)
+}
+
+/*
+CompiledHeader holds everything about a header line that does not depend on the data line, i.e. the
+split header fields, the separator field and (for mode, mtd and other non stat files) the line type.
+A file normally has a single header line so a header only needs to be compiled once per file, and then
+CompiledHeader.GetLineType can be called for every data line in the file.
+*/
+type CompiledHeader struct {
+	HeaderLine         string
+	FileName           string
+	Version            string
+	Fields             []string // all of the header line fields
+	HeaderStringFields []string // the header section fields, up to and including the separator field
+	SeparatorField     string
+	FileLineType       string // only known from the header for non LINE_TYPE separated files
+	FileType           string // the file type of a LINE_TYPE separated custom line type, see RegisterCustomLineType
+	DescIndex          int
+}
+
+// CompileHeader does the per header work of GetLineType. See GetLineType for the fileName assumptions.
+func CompileHeader(headerLine string, fileName string, version string) *CompiledHeader {
 	// make sure we have the basename here
 	fileName = filepath.Base(fileName)
 	desc_index := -1
 	allHeaderFields := strings.Fields(headerLine)
-	// get the data fields from the data line
-	allData := strings.Fields(dataLine)
 
 	// different fileTypes have different separator fields
 	separatorField := "LINE_TYPE"
@@ -235,6 +284,7 @@ func GetLineType(headerLine string, dataLine string, fileName string, version st
 	// If the base fileName is an mtd file (i.e. starts with "mtd_") then it can be treated
 	// as an mtd type file and the line separator is also OBJECT_ID.
 	var fileLineType string
+	var fileType string
 	if strings.Contains(fileName, "stat") {
 		separatorField = "LINE_TYPE"
 	} else if strings.Contains(fileName, "mode") {
@@ -262,28 +312,21 @@ func GetLineType(headerLine string, dataLine string, fileName string, version st
 		// check for differently named files?
 		// there appear to be some files that aren't named like the others
 		// Check the header line to see if it matches a line in the column defs file
-		// if it does then we can use the line type from the column defs file
-		columnDefHeaderFields, err := getLineTypeFromColumnDefsFile(headerLine, version)
-		if err == nil {
+		// if it does then we can use the line type from the column defs file.
+		// The header lines of the custom line types are checked first, they are not in the column defs file.
+		if custom, ok := getCustomLineTypeForHeader(headerLine); ok {
+			separatorField = custom.Separator()
+			if separatorField == "LINE_TYPE" {
+				fileType = custom.FileType
+			} else {
+				fileLineType = custom.FileLineType()
+			}
+		} else if columnDefHeaderFields, err := getLineTypeFromColumnDefsFile(headerLine, version); err == nil {
 			fileLineType = columnDefHeaderFields.FileType + "_" + columnDefHeaderFields.LineType
 			separatorField = columnDefHeaderFields.SeparatorField
 		}
 	}
 	headerStringFields, _ := SplitColumnDefLine(fileLineType, headerLine)
-	dataStartIndex := len(headerStringFields)
-	dataData := allData[dataStartIndex:]
-	lineTypeIndex := len(headerStringFields) - 1
-	if lineTypeIndex > len(allData) {
-		return "", nil, nil, "", desc_index, fmt.Errorf("UNPARSABLE_LINE: lineTypeIndex is greater than the length of the data line")
-	}
-	// now we know the lineType for  files.
-	if separatorField == "LINE_TYPE" {
-		if strings.Contains(fileName, "tcst") {
-			fileLineType = "TCST" + "_" + allData[lineTypeIndex]
-		} else {
-			fileLineType = "STAT" + "_" + allData[lineTypeIndex]
-		}
-	}
 	// get the desc_index
 	for i, h := range headerStringFields {
 		if strings.ToUpper(h) == "DESC" {
@@ -291,14 +334,109 @@ func GetLineType(headerLine string, dataLine string, fileName string, version st
 			break
 		}
 	}
+	return &CompiledHeader{
+		HeaderLine:         headerLine,
+		FileName:           fileName,
+		Version:            version,
+		Fields:             allHeaderFields,
+		HeaderStringFields: headerStringFields,
+		SeparatorField:     separatorField,
+		FileLineType:       fileLineType,
+		FileType:           fileType,
+		DescIndex:          desc_index,
+	}
+}
+
+// LineType returns the fileLineType of a data line i.e. STAT_CNT, or the FileLineType of the header if the line is too short to have a LINE_TYPE.
+func (h *CompiledHeader) LineType(dataLine string) string {
+	return h.lineType(strings.Fields(dataLine))
+}
+
+// lineType returns the fileLineType of the fields of a data line, the LINE_TYPE column of a LINE_TYPE separated file has the line type.
+func (h *CompiledHeader) lineType(allData []string) string {
+	lineTypeIndex := len(h.HeaderStringFields) - 1
+	if h.SeparatorField != "LINE_TYPE" || lineTypeIndex < 0 || lineTypeIndex >= len(allData) {
+		return h.FileLineType
+	}
+	if h.FileType != "" {
+		return h.FileType + "_" + allData[lineTypeIndex]
+	} else if strings.Contains(h.FileName, "tcst") {
+		return "TCST" + "_" + allData[lineTypeIndex]
+	}
+	return "STAT" + "_" + allData[lineTypeIndex]
+}
+
+// lineTypeDataColumns caches the data columns of the line types, by version and fileLineType.
+var lineTypeDataColumns sync.Map
+
+/*
+dataColumnIndex returns the index in a data line of a data column of a line type, or -1 if the column is not a
+data column or follows a repeated sequence, whose length is only known from the line. The data columns are the
+ones of the column definitions of the version, or of the nearest older version that has column definitions.
+*/
+func (h *CompiledHeader) dataColumnIndex(fileLineType string, column string) int {
+	key := h.Version + ":" + fileLineType
+	cached, ok := lineTypeDataColumns.Load(key)
+	if !ok {
+		_, dataColumns, err := LineTypeColumns(h.Version, fileLineType)
+		if errors.Is(err, ErrUnsupportedVersion) {
+			if set, nearestErr := NearestLineTypeSet(h.Version); nearestErr == nil {
+				_, dataColumns, _ = LineTypeColumns(set.Version(), fileLineType)
+			}
+		}
+		cached, _ = lineTypeDataColumns.LoadOrStore(key, dataColumns)
+	}
+	for i, dataColumn := range cached.([]string) {
+		if dataColumn == column {
+			return len(h.HeaderStringFields) + i
+		}
+		if strings.HasPrefix(dataColumn, "(") || strings.Contains(dataColumn, "[0-9]*") {
+			break
+		}
+	}
+	return -1
+}
+
+// GetLineType is the per data line part of the package GetLineType function.
+func (h *CompiledHeader) GetLineType(dataLine string) (string, []string, []string, string, int, error) {
+	return h.GetLineTypeWithDataKeys(dataLine, nil)
+}
+
+/*
+GetLineTypeWithDataKeys is GetLineType with the DataKeyMap entries of dataKeyMap in place of those of DataKeyMap.
+The header columns that are data keys, HeaderDisallow columns or not IdColumns are "" in the header data, the
+HeaderDisallow columns of DataKeyMap are not added to the data, see parser.Parser for that.
+*/
+func (h *CompiledHeader) GetLineTypeWithDataKeys(dataLine string, dataKeyMap map[string]DataKeyEntry) (string, []string, []string, string, int, error) {
+	desc_index := h.DescIndex
+	allHeaderFields := h.Fields
+	headerStringFields := h.HeaderStringFields
+	// get the data fields from the data line
+	allData := strings.Fields(dataLine)
+	dataStartIndex := len(headerStringFields)
+	lineTypeIndex := len(headerStringFields) - 1
+	if dataStartIndex > len(allData) {
+		return "", nil, nil, "", desc_index, fmt.Errorf("UNPARSABLE_LINE: lineTypeIndex is greater than the length of the data line: %w", ErrTruncatedLine)
+	}
+	dataData := allData[dataStartIndex:]
+	// now we know the lineType for  files.
+	fileLineType := h.lineType(allData)
+	dataKeyEntry, ok := dataKeyMap[fileLineType]
+	if !ok {
+		dataKeyEntry, _ = GetDataKeyEntry(fileLineType)
+	}
 	// have to remove the DataKeyFields from the headerFields and the headerData (dataData AND dataFields won't change)
 	headerData := []string{}
 	DataKeyFields := []string{}
 	// look for DataKey fields in allData - remove the DataKeyFields from the headerData if the key is in the header
 	// or if the key is in the disallowed header fields
 	for fIndex, field := range allHeaderFields {
+		if fIndex >= len(allData) {
+			// the data section of the line is truncated, any data section DataKey fields are missing
+			break
+		}
 		isDataKey := false
-		for _, dk := range DataKeyMap[fileLineType].DataKey {
+		for _, dk := range dataKeyEntry.DataKey {
 			if field == dk {
 				isDataKey = true
 				if (dk == "LEAD" || dk == "FCST_LEAD") && allData[fIndex] == "NA" {
@@ -322,12 +460,16 @@ func GetLineType(headerLine string, dataLine string, fileName string, version st
 			}
 		}
 		// handle disallowed header fields
-		for _, disDk := range DataKeyMap[fileLineType].HeaderDisallow {
+		for _, disDk := range dataKeyEntry.HeaderDisallow {
 			if field == disDk {
 				isDataKey = true
 				break
 			}
 		}
+		// the header fields that are not id columns are left out like the disallowed ones
+		if len(dataKeyEntry.IdColumns) > 0 && !slices.Contains(dataKeyEntry.IdColumns, field) {
+			isDataKey = true
+		}
 		isHeaderField := fIndex <= lineTypeIndex
 		// iterate through the header fields and
 		// if the field is a header field and a DataKeyField then blank it out
@@ -356,10 +498,27 @@ func GetLineType(headerLine string, dataLine string, fileName string, version st
 			}
 		}
 	}
-	DataKey := strings.Join(DataKeyFields, "_")
+	// the data key columns in the data section of a header line that does not name the data columns, i.e. the
+	// INDEX and OBS_SID of an MPR line in a .stat file, are found from the data columns of the line type
+	for _, dk := range dataKeyEntry.DataKey {
+		if slices.Contains(allHeaderFields, dk) {
+			continue
+		}
+		if index := h.dataColumnIndex(fileLineType, dk); index >= 0 && index < len(allData) && allData[index] != "NA" {
+			DataKeyFields = append(DataKeyFields, allData[index])
+		}
+	}
+	separator := dataKeyEntry.Separator
+	if separator == "" {
+		separator = "_"
+	}
+	DataKey := strings.Join(DataKeyFields, separator)
 	if DataKey == "" {
 		// if the DataKey is empty this is an error
-		return "", nil, nil, "", desc_index, fmt.Errorf("UNPARSABLE_LINE: DataKey is empty")
+		if _, ok := GetDataKeyEntry(fileLineType); !ok {
+			return fileLineType, nil, nil, "", desc_index, fmt.Errorf("UNPARSABLE_LINE: %w: %q", ErrUnknownLineType, fileLineType)
+		}
+		return fileLineType, nil, nil, "", desc_index, fmt.Errorf("UNPARSABLE_LINE: DataKey is empty: %w", ErrMissingDataKey)
 	}
 	// return the lineType, the headerData, the dataData, the DataKey, and the desc_index
 	return fileLineType, headerData, dataData, DataKey, desc_index, nil
@@ -389,6 +548,7 @@ func SplitColumnDefLine(fileLineType string, headerLine string) ([]string, []str
 			dataString = ""
 		}
 	case "MODE_OBJ", "MODE_CTS":
+		// the separator fields OBJECT_ID and FIELD are the first data columns, they are part of the data key
 		parts = strings.Split(headerLine, " OBTYPE ")
 		if len(parts) > 1 {
 			headerString = parts[0] + " OBTYPE"
@@ -398,10 +558,14 @@ func SplitColumnDefLine(fileLineType string, headerLine string) ([]string, []str
 			dataString = ""
 		}
 	default:
-		// get the header fields from line
-		parts = strings.Split(headerLine, " LINE_TYPE ")
+		// get the header fields from line, a custom line type can have another separator field
+		separatorField := "LINE_TYPE"
+		if custom, ok := GetCustomLineType(fileLineType); ok {
+			separatorField = custom.Separator()
+		}
+		parts = strings.Split(headerLine, " "+separatorField+" ")
 		if len(parts) > 1 {
-			headerString = parts[0] + " LINE_TYPE"
+			headerString = parts[0] + " " + separatorField
 			dataString = parts[1]
 		} else {
 			headerString = parts[0]
@@ -453,37 +617,29 @@ func GetId(dataSetName string, tmpHeaderData []string, metaData *VxMetadata) (Vx
 	idElems = append(idElems, tmpHeaderData...)
 	id := strings.Join(idElems, ":")
 	if len(id) > 250 {
-		return VxMetadata{}, fmt.Errorf("calculated ID is too long: %d - id:\"%s\"", len(id), id)
+		return VxMetadata{}, fmt.Errorf("calculated ID is too long: %d - id:\"%s\": %w", len(id), id, ErrIdTooLong)
 	}
 	metaData.ID = id
 	return *metaData, nil
 }
 
 func getLineTypeFromColumnDefsFile(headerLine string, version string) (HeaderFields, error) {
-	// this function will read the column definitions file and return the line type for the header line
-	// if it is found in the column definitions file
-	// If the columnsDefinition file is not present then the file will be downloaded from the
-	// linetypes.MetHeaderColumnsFileUrl
-	switch version {
-	case "v12_0":
-		MetHeaderColumnsFileUrl = MetHeaderColumnsFileUrl_v12_0
-	case "v11_1":
-		MetHeaderColumnsFileUrl = MetHeaderColumnsFileUrl_v11_1
-	case "v11_0":
-		MetHeaderColumnsFileUrl = MetHeaderColumnsFileUrl_v11_0
-	case "v10_1":
-		MetHeaderColumnsFileUrl = MetHeaderColumnsFileUrl_v10_1
-	case "v10_0":
-		MetHeaderColumnsFileUrl = MetHeaderColumnsFileUrl_v10_0
-	default:
-		log.Printf("version not found, %s - using v12.0", version)
-		return HeaderFields{}, fmt.Errorf("unsupported version %s", version)
+	// this function will look the header line up in the embedded column definitions (met_header_columns) table
+	// of the version and return the line type for the header line if it is found in the table.
+	// A version that has no table is looked up in the table of the nearest older supported version.
+	columnDefsLines, err := ColumnDefs(version)
+	if err != nil {
+		set, nearestErr := NearestLineTypeSet(version)
+		if nearestErr != nil {
+			return HeaderFields{}, err
+		}
+		if columnDefsLines, err = ColumnDefs(set.Version()); err != nil {
+			return HeaderFields{}, err
+		}
 	}
 
 	var columnDefHeaderFields HeaderFields
 	var trimmedColumnDefsLine string
-	var lines []string
-	wd, _ := os.Getwd()
 	if strings.Contains(headerLine, " CYCLONE ") {
 		// these are TCST files
 		columnDefHeaderFields.FileType = "TCST"
@@ -531,49 +687,19 @@ func getLineTypeFromColumnDefsFile(headerLine string, version string) (HeaderFie
 		}
 		return columnDefHeaderFields, nil
 	}
-	// If I made it to here I couldn't parse it from the headerline easily so I have to read the column definitions file
-	// and find the line type for the header line by looking line by line in the column definitions file.
-	// The column definitions file is a text file that contains the line type for each header line.
-	// If the column definitions file is not present then the file will be downloaded from the
-	// linetypes.MetHeaderColumnsFileUrl.
-	columnDefsFilePath := wd + "/" + "./column_defs.txt"
-	_, err := os.Stat(columnDefsFilePath)
-	if os.IsNotExist(err) {
-		resp, err := http.Get(MetHeaderColumnsFileUrl)
-		if err != nil {
-			return HeaderFields{}, err
-		}
-		defer resp.Body.Close()
-		out, err := os.Create(columnDefsFilePath)
-		if err != nil {
-			return HeaderFields{}, err
-		}
-		defer out.Close()
-		// Write the body to file
-		_, err = io.Copy(out, resp.Body)
-		if err != nil {
-			fmt.Println("error getting met_header_columns file: " + err.Error())
-			return HeaderFields{}, err
-		}
-	}
-	// read the column definitions file
-	rawColumnsBytes, err := os.ReadFile(columnDefsFilePath)
-	if err != nil {
-		return HeaderFields{}, err
-	}
-	// Either the ColumnsDefinitions file is present or we just downloaded it
-	// Now we need to find the header line in the file
-	lines = strings.Split(string(rawColumnsBytes), "\n")
+	// If I made it to here I couldn't parse it from the headerline easily so I have to look line by line
+	// in the column definitions table for the line type of the header line.
 	// find the trimmed header line in the column definitions file.
 	trimmedHeaderLine := strings.Join(strings.Fields(headerLine), " ")
 	found := false
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
+	for _, line := range columnDefsLines {
 		// ignore the first 3 fields of the columndefs line for comparison
 		// squeeze extra white space out of the line and remove the first 3 prefix fields
-		trimmedColumnDefsLine = strings.Split(strings.Join(strings.Fields(line), " "), ": ")[3]
+		prefixFields := strings.SplitN(strings.Join(strings.Fields(line), " "), ": ", 4)
+		if len(prefixFields) < 4 {
+			continue
+		}
+		trimmedColumnDefsLine = prefixFields[3]
 		if strings.HasPrefix(trimmedHeaderLine, trimmedColumnDefsLine) {
 			columnDefHeaderFields.Header = line
 			lineFields := strings.Split(columnDefHeaderFields.Header, ":")
@@ -601,6 +727,29 @@ func getLineTypeFromColumnDefsFile(headerLine string, version string) (HeaderFie
 		return columnDefHeaderFields, nil
 	} else {
 		// we didn't find the separator so we can't process this line
-		return HeaderFields{}, fmt.Errorf("separator not found in column definitions file")
+		return HeaderFields{}, fmt.Errorf("separator not found in column definitions file: %w", ErrUnknownLineType)
+	}
+}
+
+// ToCamelCase returns the JSON name of a MET column i.e. fcstValid for FCST_VALID.
+func ToCamelCase(s string) string {
+	// Remove all characters that are not alphanumeric, spaces, hyphens or underscores
+	s = regexp.MustCompile("[^a-zA-Z0-9-_ ]+").ReplaceAllString(s, "")
+
+	// Replace all underscores & hyphens with spaces
+	s = strings.ReplaceAll(s, "_", " ")
+	s = strings.ReplaceAll(s, "-", " ")
+
+	// Title case s. As part of that, switch from UPPER to lower case
+	s = cases.Title(language.AmericanEnglish).String(s)
+
+	// Remove all spaces
+	s = strings.ReplaceAll(s, " ", "")
+
+	// Lowercase the first letter
+	if len(s) > 0 {
+		s = strings.ToLower(s[:1]) + s[1:]
 	}
+
+	return s
 }
@@ -1,6 +1,11 @@
 package util
 
 import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -86,6 +91,106 @@ func TestGetLineType(t *testing.T) {
 	}
 }
 
+func TestGetLineTypeErrors(t *testing.T) {
+	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VALID_END OBS_LEAD OBS_VALID_BEG OBS_VALID_END FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
+	tests := []struct {
+		name     string
+		dataLine string
+		wantErr  error
+	}{
+		{
+			name:     "truncated line",
+			dataLine: "V12.0.0 ECMWF NA 060000 20241031_000000",
+			wantErr:  ErrTruncatedLine,
+		},
+		{
+			name:     "unknown line type",
+			dataLine: "V12.0.0 ECMWF NA 060000 20241031_000000 20241031_000000 000000 20241031_000000 20241031_000000 TMP K P1000 TMP K P1000 ANLYS FULL NEAREST 1 NA NA NA NA NOTALINE 1 2 3",
+			wantErr:  ErrUnknownLineType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, _, _, err := GetLineType(headerLine, tt.dataLine, "point_stat_360000L_20070331_120000V_sal1l2.stat", "v12_0")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetLineType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatchedPairDataKeys(t *testing.T) {
+	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VALID_END OBS_LEAD OBS_VALID_BEG OBS_VALID_END FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
+	header := "V12.0.0 GFS NA 120000 20241104_180000 20241104_180000 000000 20241104_180000 20241104_180000 TMP K Z2 TMP K Z2 ADPSFC CONUS NEAREST 1 NA NA NA NA "
+	tests := []struct {
+		data    string
+		wantKey string
+	}{
+		{data: "MPR 10 7 KDEN 39.85 -104.66 NA 1655 271.5 270.9", wantKey: "120000:7:KDEN"},
+		{data: "ORANK 10 8 KBOU 40.01 -105.25 NA 1655 270.2 0.5 3 5 5 270.1", wantKey: "120000:8:KBOU"},
+		{data: "SEEPS_MPR KDEN 39.85 -104.66 1.5 2.0 NA 1 2", wantKey: "120000:KDEN:39.85:-104.66"},
+		{data: "GENMPR 4 2 AL092024 24 0.5", wantKey: "120000:2:AL092024"},
+	}
+	for _, tt := range tests {
+		_, _, _, key, _, err := GetLineType(headerLine, header+tt.data, "point_stat_GFS.stat", "v12_0")
+		if err != nil || key != tt.wantKey {
+			t.Errorf("GetLineType() key = %v, %v, want %v", key, err, tt.wantKey)
+		}
+	}
+	// a missing key column is left out of the key of a pair, the INDEX still tells the pairs apart
+	for data, wantKey := range map[string]string{
+		"MPR 10 9 NA 40.50 -105.00 NA 1600 269.8 269.0":   "120000:9",
+		"ORANK 10 9 NA 40.50 -105.00 NA 1600 269.8 0.5 3": "120000:9",
+		"GENMPR 4 3 NA 24 0.5":                            "120000:3",
+	} {
+		if _, _, _, key, _, err := GetLineType(headerLine, header+data, "point_stat_GFS.stat", "v12_0"); err != nil || key != wantKey {
+			t.Errorf("GetLineType(%s) key = %v, %v, want %v", data, key, err, wantKey)
+		}
+	}
+}
+
+func TestModeCtsDataKeys(t *testing.T) {
+	headerLine := "VERSION MODEL N_VALID GRID_RES DESC FCST_LEAD FCST_VALID FCST_ACCUM OBS_LEAD OBS_VALID OBS_ACCUM FCST_RAD FCST_THR OBS_RAD OBS_THR FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE FIELD TOTAL FY_OY FY_ON FN_OY FN_ON BASER FMEAN ACC FBIAS PODY PODN POFD FAR CSI GSS HK HSS ODDS LODDS ORSS EDS SEDS EDI SEDI BAGSS"
+	header := "V12.0.0 WRF 1 4 NA 300000 20120410_180000 060000 000000 20120410_180000 060000 2 >=5.0 2 >=5.0 APCP_06 kg/m^2 A6 APCP_06 kg/m^2 A6 MC_PCP "
+	values := " 1000 10 5 5 980 0.015 0.015 0.985 1 0.667 0.995 0.333 0.333 0.5 0.486 0.662 0.653 399 5.99 0.995 0.9 0.9 0.9 0.9 0.6"
+	// the file type is known from the name of a _cts.txt file or from the FIELD column of its header line
+	for _, fileName := range []string{"mode_WRF_300000L_20120410_180000V_060000A_cts.txt", "renamed_output.txt"} {
+		raw, rawHeaderData, rawData, rawKey, _, err := GetLineType(headerLine, header+"RAW"+values, fileName, "v12_0")
+		if err != nil || raw != "MODE_CTS" || rawKey != "300000_RAW" || rawData[0] != "RAW" {
+			t.Errorf("GetLineType(%s) RAW = %v, %v, %v, %v", fileName, raw, rawKey, rawData, err)
+		}
+		_, objectHeaderData, _, objectKey, _, err := GetLineType(headerLine, header+"OBJECT"+values, fileName, "v12_0")
+		if err != nil || objectKey != "300000_OBJECT" {
+			t.Errorf("GetLineType(%s) OBJECT key = %v, %v", fileName, objectKey, err)
+		}
+		// the RAW and OBJECT lines go to the same document
+		if !slices.Equal(rawHeaderData, objectHeaderData) {
+			t.Errorf("GetLineType(%s) header data %v != %v", fileName, rawHeaderData, objectHeaderData)
+		}
+	}
+}
+
+func TestGetLineTypeWithDataKeys(t *testing.T) {
+	headerLine := "VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VALID_END OBS_LEAD OBS_VALID_BEG OBS_VALID_END FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE"
+	dataLine := "V12.0.0 GFS NA 120000 20241104_180000 20241104_180000 000000 20241104_180000 20241104_180000 TMP K Z2 TMP K Z2 ADPSFC CONUS NEAREST 1 NA NA NA NA FHO 10 0.5 0.4 0.6"
+	header := CompileHeader(headerLine, "grid_stat_GFS.stat", "v12_0")
+	// keyed by VX_MASK, FCST_LEAD is in the header
+	_, headerData, _, key, _, err := header.GetLineTypeWithDataKeys(dataLine, map[string]DataKeyEntry{"STAT_FHO": {DataKey: []string{"VX_MASK"}}})
+	if err != nil || key != "CONUS" || headerData[3] != "120000" || headerData[16] != "" {
+		t.Errorf("GetLineTypeWithDataKeys() = %v, %v, %v", key, headerData, err)
+	}
+	// only the id columns are in the header
+	_, headerData, _, key, _, err = header.GetLineTypeWithDataKeys(dataLine, map[string]DataKeyEntry{"STAT_FHO": {DataKey: []string{"FCST_LEAD"}, IdColumns: []string{"VERSION", "MODEL", "LINE_TYPE"}}})
+	if err != nil || key != "120000" || strings.Join(headerData, " ") != "V12.0.0 GFS"+strings.Repeat(" ", 22)+"FHO" {
+		t.Errorf("GetLineTypeWithDataKeys() = %v, %q, %v", key, headerData, err)
+	}
+	// the other line types are keyed by DataKeyMap
+	_, _, _, key, _, err = header.GetLineTypeWithDataKeys(dataLine, map[string]DataKeyEntry{"STAT_CNT": {DataKey: []string{"VX_MASK"}}})
+	if err != nil || key != "120000" {
+		t.Errorf("GetLineTypeWithDataKeys() key = %v, %v, want 120000", key, err)
+	}
+}
+
 func TestSplitColumnDefLine(t *testing.T) {
 	tests := []struct {
 		fileType   string
@@ -101,6 +206,14 @@ func TestSplitColumnDefLine(t *testing.T) {
 			wantHeader: []string{"VERSION", "MODEL", "N_VALID", "GRID_RES", "DESC", "FCST_LEAD", "FCST_VALID", "FCST_ACCUM", "OBS_LEAD", "OBS_VALID", "OBS_ACCUM", "FCST_RAD", "FCST_THR", "OBS_RAD", "OBS_THR", "FCST_VAR", "FCST_UNITS", "FCST_LEV", "OBS_VAR", "OBS_UNITS", "OBS_LEV", "OBTYPE"},
 			wantData:   []string{"OBJECT_ID", "OBJECT_CAT", "CENTROID_X", "CENTROID_Y", "CENTROID_LAT", "CENTROID_LON", "AXIS_ANG", "LENGTH", "WIDTH", "AREA", "AREA_THRESH", "CURVATURE", "CURVATURE_X", "CURVATURE_Y", "COMPLEXITY", "INTENSITY_10", "INTENSITY_25", "INTENSITY_50", "INTENSITY_75", "INTENSITY_90", "INTENSITY_95", "INTENSITY_SUM", "CENTROID_DIST", "BOUNDARY_DIST", "CONVEX_HULL_DIST", "ANGLE_DIFF", "ASPECT_DIFF", "AREA_RATIO", "INTERSECTION_AREA", "UNION_AREA", "SYMMETRIC_DIFF", "INTERSECTION_OVER_AREA", "CURVATURE_RATIO", "COMPLEXITY_RATIO", "PERCENTILE_INTENSITY_RATIO", "INTEREST"},
 		},
+		{
+			// FIELD is the first data column of MODE_CTS, GetLineType finds its value for the data key there
+			fileType:   "MODE_CTS",
+			fileName:   "mode_PERC_THRESH_300000L_20120410_180000V_060000A_cts.txt",
+			fieldStr:   "VERSION MODEL N_VALID GRID_RES DESC FCST_LEAD FCST_VALID FCST_ACCUM OBS_LEAD OBS_VALID OBS_ACCUM FCST_RAD FCST_THR OBS_RAD OBS_THR FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE FIELD TOTAL FY_OY FY_ON FN_OY FN_ON",
+			wantHeader: []string{"VERSION", "MODEL", "N_VALID", "GRID_RES", "DESC", "FCST_LEAD", "FCST_VALID", "FCST_ACCUM", "OBS_LEAD", "OBS_VALID", "OBS_ACCUM", "FCST_RAD", "FCST_THR", "OBS_RAD", "OBS_THR", "FCST_VAR", "FCST_UNITS", "FCST_LEV", "OBS_VAR", "OBS_UNITS", "OBS_LEV", "OBTYPE"},
+			wantData:   []string{"FIELD", "TOTAL", "FY_OY", "FY_ON", "FN_OY", "FN_ON"},
+		},
 		{
 			fileType:   "MTD",
 			fileName:   "mtd_SINGLE_20100517_010000V_3d_single_simple.txt",
@@ -268,3 +381,303 @@ func equal(a, b []string) bool {
 	}
 	return true
 }
+
+func TestSortVersions(t *testing.T) {
+	versions := []string{"v12_0", "v9_1", "v10_1", "bad", "v10_10", "v10_0", "v11_1"}
+	SortVersions(versions)
+	want := []string{"v9_1", "v10_0", "v10_1", "v10_10", "v11_1", "v12_0", "bad"}
+	if !slices.Equal(versions, want) {
+		t.Errorf("SortVersions() = %v, want %v", versions, want)
+	}
+}
+
+// testLineTypeSet is a LineTypeSet for a version that does not exist.
+type testLineTypeSet struct{}
+
+func (testLineTypeSet) Version() string { return "v0_1" }
+
+func (testLineTypeSet) GetDocForId(fileLineType string, metaDataMap map[string]interface{}, headerData []string, dataData []string, dataKey string) (map[string]interface{}, error) {
+	return map[string]interface{}{"data": dataKey}, nil
+}
+
+func (testLineTypeSet) AddDataElement(dataKey string, fileLineType string, dataData []string, doc *map[string]interface{}) (map[string]interface{}, error) {
+	return *doc, nil
+}
+
+func (testLineTypeSet) ColumnDefsUrl() string { return "" }
+
+func (testLineTypeSet) LineTypes() []string { return []string{"STAT_TEST"} }
+
+func (testLineTypeSet) HeaderColumns(fileLineType string) []string {
+	return []string{"VERSION", "LINE_TYPE"}
+}
+
+func TestRegisterLineTypeSet(t *testing.T) {
+	if _, err := GetLineTypeSet("v0_1"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("GetLineTypeSet() error = %v, want ErrUnsupportedVersion", err)
+	}
+	RegisterLineTypeSet(testLineTypeSet{})
+	set, err := GetLineTypeSet("v0_1")
+	if err != nil {
+		t.Fatalf("GetLineTypeSet() error = %v", err)
+	}
+	if !slices.Equal(set.LineTypes(), []string{"STAT_TEST"}) {
+		t.Errorf("LineTypes() = %v", set.LineTypes())
+	}
+	if !slices.Contains(LineTypeSetVersions(), "v0_1") {
+		t.Errorf("LineTypeSetVersions() = %v, want v0_1", LineTypeSetVersions())
+	}
+	// the nearest version is the newest version of the same major release that is not newer
+	for _, version := range []string{"v0_1", "v0_9"} {
+		if set, err := NearestLineTypeSet(version); err != nil || set.Version() != "v0_1" {
+			t.Errorf("NearestLineTypeSet(%s) = %v, %v, want v0_1", version, set, err)
+		}
+	}
+	for _, version := range []string{"v0_0", "v1_0"} {
+		if _, err := NearestLineTypeSet(version); !errors.Is(err, ErrUnsupportedVersion) {
+			t.Errorf("NearestLineTypeSet(%s) error = %v, want ErrUnsupportedVersion", version, err)
+		}
+	}
+	// registering the same version twice panics
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterLineTypeSet() did not panic for a duplicate version")
+		}
+	}()
+	RegisterLineTypeSet(testLineTypeSet{})
+}
+
+// registerTestLayouts registers a function that adds no layouts, the util tests do not import the engine package.
+var registerTestLayouts = sync.OnceFunc(func() {
+	RegisterCustomLayouts(func(CustomLineType) error { return nil })
+})
+
+func TestRegisterCustomLineType(t *testing.T) {
+	custom := CustomLineType{
+		FileType:       "TOOL",
+		LineType:       "SCORES",
+		Columns:        "VERSION MODEL FCST_LEAD FCST_VALID_BEG OBJ_ID TOTAL SCORE",
+		SeparatorField: "OBJ_ID",
+		ColumnTypes:    map[string]string{"TOTAL": "int", "SCORE": "float64"},
+		DataKey:        DataKeyEntry{DataKey: []string{"FCST_LEAD"}},
+	}
+	invalid := []func(c *CustomLineType){
+		func(c *CustomLineType) { c.LineType = "scores" },
+		func(c *CustomLineType) { c.Columns = "MODEL VERSION FCST_LEAD OBJ_ID TOTAL" },
+		func(c *CustomLineType) { c.SeparatorField = "" },
+		func(c *CustomLineType) { c.Columns = "VERSION MODEL FCST_LEAD OBJ_ID" },
+		func(c *CustomLineType) { c.DataKey = DataKeyEntry{DataKey: []string{"LEAD"}} },
+		func(c *CustomLineType) { c.DataKey.HeaderDisallow = []string{"SCORE"} },
+		func(c *CustomLineType) { c.ColumnTypes = map[string]string{"SCORE": "double"} },
+		func(c *CustomLineType) { c.RepeatingGroups = []RepeatingGroup{{Term: "(N_THRESH)"}} },
+		func(c *CustomLineType) { c.FileType, c.LineType = "STAT", "CNT" },
+	}
+	// a line type cannot be registered without the engine that builds its layout
+	registerTestLayouts()
+	saved := addCustomLayout
+	addCustomLayout = nil
+	if err := RegisterCustomLineType(custom); !errors.Is(err, ErrInvalidLineType) || IsCustomLineType("TOOL_SCORES") {
+		t.Errorf("RegisterCustomLineType() error = %v, want ErrInvalidLineType without layouts", err)
+	}
+	addCustomLayout = saved
+	for i, change := range invalid {
+		c := custom
+		change(&c)
+		if err := RegisterCustomLineType(c); !errors.Is(err, ErrInvalidLineType) {
+			t.Errorf("RegisterCustomLineType() %d error = %v, want ErrInvalidLineType", i, err)
+		}
+	}
+	if err := RegisterCustomLineType(custom); err != nil {
+		t.Fatalf("RegisterCustomLineType() error = %v", err)
+	}
+	if err := RegisterCustomLineType(custom); !errors.Is(err, ErrInvalidLineType) {
+		t.Errorf("RegisterCustomLineType() error = %v, want ErrInvalidLineType for a duplicate", err)
+	}
+	if !IsCustomLineType("TOOL_SCORES") || !slices.Contains(CustomLineTypes(), "TOOL_SCORES") {
+		t.Errorf("CustomLineTypes() = %v, want TOOL_SCORES", CustomLineTypes())
+	}
+	if entry, ok := GetDataKeyEntry("TOOL_SCORES"); !ok || !slices.Equal(entry.DataKey, []string{"FCST_LEAD"}) {
+		t.Errorf("GetDataKeyEntry(TOOL_SCORES) = %v, %v", entry, ok)
+	}
+	// the line type of a file that is not named like a MET file is found from its header line
+	header := CompileHeader("VERSION MODEL FCST_LEAD FCST_VALID_BEG  OBJ_ID TOTAL SCORE", "tool_output.txt", "v12_0")
+	fileLineType, headerData, dataData, dataKey, _, err := header.GetLineType("V12.0.0 GFS 060000 20241104_180000 obj1 10 0.5")
+	if err != nil {
+		t.Fatalf("GetLineType() error = %v", err)
+	}
+	if fileLineType != "TOOL_SCORES" || dataKey != "060000" || !slices.Equal(dataData, []string{"10", "0.5"}) {
+		t.Errorf("GetLineType() = %s, %v, %v, %s", fileLineType, headerData, dataData, dataKey)
+	}
+}
+
+// TestRegisterCustomLineTypeWhileParsing registers custom line types while lines are parsed, run it with -race.
+func TestRegisterCustomLineTypeWhileParsing(t *testing.T) {
+	header := CompileHeader("VERSION MODEL DESC FCST_LEAD FCST_VALID_BEG FCST_VALID_END OBS_LEAD OBS_VALID_BEG OBS_VALID_END FCST_VAR FCST_UNITS FCST_LEV OBS_VAR OBS_UNITS OBS_LEV OBTYPE VX_MASK INTERP_MTHD INTERP_PNTS FCST_THRESH OBS_THRESH COV_THRESH ALPHA LINE_TYPE", "point_stat_120000L_20241104_120000V.stat", "v12_0")
+	dataLine := "V12.0.0 GFS NA 120000 20241104_120000 20241104_120000 000000 20241104_120000 20241104_120000 TMP K P500 TMP K P500 ADPUPA FULL NEAREST 1 NA NA NA NA FHO 10 0.5 0.5 0.5"
+	registerTestLayouts()
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			custom := CustomLineType{
+				FileType: "RACE",
+				LineType: "SCORES_" + strconv.Itoa(i),
+				Columns:  "VERSION MODEL FCST_LEAD LINE_TYPE TOTAL",
+				DataKey:  DataKeyEntry{DataKey: []string{"FCST_LEAD"}},
+			}
+			if err := RegisterCustomLineType(custom); err != nil {
+				t.Errorf("RegisterCustomLineType() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if _, _, _, dataKey, _, err := header.GetLineType(dataLine); err != nil || dataKey != "120000" {
+					t.Errorf("GetLineType() = %s, %v", dataKey, err)
+				}
+				GetDataKeyEntry("RACE_SCORES_" + strconv.Itoa(i))
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range 4 {
+		if _, ok := GetDataKeyEntry("RACE_SCORES_" + strconv.Itoa(i)); !ok {
+			t.Errorf("GetDataKeyEntry(RACE_SCORES_%d) is missing", i)
+		}
+	}
+}
+
+func TestColumnDefs(t *testing.T) {
+	wantVersions := []string{"v10_0", "v10_1", "v11_0", "v11_1", "v12_0"}
+	if versions := ColumnDefsVersions(); !slices.Equal(versions, wantVersions) {
+		t.Errorf("ColumnDefsVersions() = %v, want %v", versions, wantVersions)
+	}
+	lines, err := ColumnDefs("v12_0")
+	if err != nil {
+		t.Fatalf("ColumnDefs() error = %v", err)
+	}
+	var cntColumns string
+	for _, line := range lines {
+		if columns, found := strings.CutPrefix(line, "V12.0 : STAT : CNT : "); found {
+			cntColumns = columns
+		}
+	}
+	if !strings.HasPrefix(cntColumns, "VERSION MODEL DESC FCST_LEAD") {
+		t.Fatalf("ColumnDefs() has no STAT CNT line: %q", cntColumns)
+	}
+	// the lines are a copy
+	lines[0] = ""
+	if lines, _ := ColumnDefs("v12_0"); lines[0] == "" {
+		t.Errorf("ColumnDefs() returned the embedded lines")
+	}
+	if _, err := ColumnDefs("v9_0"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("ColumnDefs(v9_0) error = %v, want ErrUnsupportedVersion", err)
+	}
+	// an unusual file name is looked up in the embedded table
+	header := CompileHeader(cntColumns, "unusual_name.txt", "v12_0")
+	if header.FileLineType != "STAT_CNT" || header.SeparatorField != "LINE_TYPE" {
+		t.Errorf("CompileHeader() = %s %s, want STAT_CNT LINE_TYPE", header.FileLineType, header.SeparatorField)
+	}
+}
+
+func TestLineTypeColumns(t *testing.T) {
+	headerColumns, dataColumns, err := LineTypeColumns("v12_0", "STAT_FHO")
+	if err != nil {
+		t.Fatalf("LineTypeColumns() error = %v", err)
+	}
+	if headerColumns[0] != "VERSION" || headerColumns[len(headerColumns)-1] != "LINE_TYPE" {
+		t.Errorf("LineTypeColumns() header columns = %v", headerColumns)
+	}
+	if !slices.Equal(dataColumns, []string{"TOTAL", "F_RATE", "H_RATE", "O_RATE"}) {
+		t.Errorf("LineTypeColumns() data columns = %v", dataColumns)
+	}
+	headerColumns, dataColumns, err = LineTypeColumns("v12_0", "MODE_OBJ")
+	if err != nil || headerColumns[len(headerColumns)-1] != "OBTYPE" || dataColumns[0] != "OBJECT_ID" {
+		t.Errorf("LineTypeColumns() = %v, %v, %v", headerColumns, dataColumns, err)
+	}
+	// the table spells the RANK column of ORANK lines as a repeated sequence
+	_, dataColumns, err = LineTypeColumns("v12_0", "STAT_ORANK")
+	if err != nil || !slices.Contains(dataColumns, "RANK") || slices.Contains(dataColumns, "(N_RANK)") {
+		t.Errorf("LineTypeColumns() data columns = %v, %v", dataColumns, err)
+	}
+	if _, _, err := LineTypeColumns("v12_0", "STAT_NOPE"); !errors.Is(err, ErrUnknownLineType) {
+		t.Errorf("LineTypeColumns() error = %v, want ErrUnknownLineType", err)
+	}
+	if _, _, err := LineTypeColumns("v9_0", "STAT_FHO"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("LineTypeColumns() error = %v, want ErrUnsupportedVersion", err)
+	}
+}
+
+func TestRespellColumns(t *testing.T) {
+	spellings := []ColumnSpelling{{LineTypes: []string{"STAT_ORANK"}, Columns: []string{"(N_RANK)", "RANK_[0-9]*"}, Column: "RANK"}}
+	columns := []string{"PIT", "(N_RANK)", "RANK_[0-9]*", "N_ENS_VLD"}
+	if got := RespellColumns(spellings, "STAT_ORANK", columns); !slices.Equal(got, []string{"PIT", "RANK", "N_ENS_VLD"}) {
+		t.Errorf("RespellColumns() = %v", got)
+	}
+	if got := RespellColumns(spellings, "STAT_RHIST", columns); !slices.Equal(got, columns) {
+		t.Errorf("RespellColumns() = %v, want the columns of another line type unchanged", got)
+	}
+}
+
+func TestColumnTypes(t *testing.T) {
+	table, err := ColumnTypes("v12_0")
+	if err != nil {
+		t.Fatalf("ColumnTypes() error = %v", err)
+	}
+	for column, want := range map[string]string{"FBAR": "float64", "TOTAL": "int", "FCST_VALID_BEG": "int", "MODEL": "string", "UNKNOWN": "string"} {
+		if got := table.ColumnType(column); got != want {
+			t.Errorf("ColumnType(%s) = %s, want %s", column, got, want)
+		}
+	}
+	group, sequence, ok := FindRepeatingGroup(table.RepeatingGroups, "(N_THRESH)", "STAT_PCT")
+	if !ok || group.StructField != "THRESH" || !slices.Equal(sequence.KeyPrefixes, []string{"THRESH_", "OY_", "ON_"}) {
+		t.Errorf("FindRepeatingGroup(N_THRESH, STAT_PCT) = %v %v %v", group, sequence, ok)
+	}
+	if _, _, ok := FindRepeatingGroup(table.RepeatingGroups, "(N_THRESH)", "STAT_CNT"); ok {
+		t.Errorf("FindRepeatingGroup(N_THRESH, STAT_CNT) found a sequence")
+	}
+	// the table is a copy
+	table.ColumnTypes["FBAR"] = "string"
+	if table, _ := ColumnTypes("v12_0"); table.ColumnType("FBAR") != "float64" {
+		t.Errorf("ColumnTypes() returned the embedded table")
+	}
+	if _, err := ColumnTypes("v9_0"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("ColumnTypes(v9_0) error = %v, want ErrUnsupportedVersion", err)
+	}
+	if name := ColumnTypesFileName("v12_0"); name != "column_types_V12.0.json" {
+		t.Errorf("ColumnTypesFileName(v12_0) = %s", name)
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"FCST_VALID", "fcstValid"},
+		{"VERSION", "version"},
+		{"N_VALID", "nValid"},
+		{"STORM_ID", "stormId"},
+		{"LINE_TYPE", "lineType"},
+		{"INIT_MASK", "initMask"},
+		{"N_CAT", "nCat"},
+		{"DIAG_N", "diagN"},
+		{"", ""},
+		{"A", "a"},
+		{"FOO_BAR_BAZ", "fooBarBaz"},
+		{"FOO__BAR", "fooBar"},
+		{"FOO BAR", "fooBar"},
+		{"FOO-BAR", "fooBar"},
+		{"INIT_12345", "init12345"},
+		{"F1_O2", "f1O2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if result := ToCamelCase(test.input); result != test.expected {
+				t.Errorf("ToCamelCase(%q) = %q, want %q", test.input, result, test.expected)
+			}
+		})
+	}
+}
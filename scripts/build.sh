@@ -20,16 +20,12 @@ for arch in arm64 amd64; do
 	for os in linux darwin; do
 		mkdir -p bin/linux/${os}/${arch}
 		echo "building for ${os}/${arch}"
-		env GOOS=${os} GOARCH=${arch} go build -o "./bin/${os}/${arch}/generator" generator/generator.go
+		env GOOS=${os} GOARCH=${arch} go build -o "./bin/${os}/${arch}/generator" ./generator
 	done
 done
 
 echo "building new linetypes"
-go run ./generator -version=v12.0 > pkg/linetypes/v12_0/linetypes.go \
-  && go run ./generator -version=v11.1 > pkg/linetypes/v11_1/linetypes.go \
-  && go run ./generator -version=v11.0 > pkg/linetypes/v11_0/linetypes.go \
-  && go run ./generator -version=v10.1 > pkg/linetypes/v10_1/linetypes.go \
-  && go run ./generator -version=v10.0 > pkg/linetypes/v10_0/linetypes.go \
+go run ./generator -linetypes-dir=pkg/linetypes \
   && golangci-lint fmt ./...
 
 echo "format and lint linetypes"